@@ -2,18 +2,32 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 	"time"
 
+	"hotel-booking-system/internal/booking-srv/config"
+	"hotel-booking-system/internal/booking-srv/hotelclient"
+	"hotel-booking-system/internal/booking-srv/jobs"
 	"hotel-booking-system/internal/booking-srv/repository"
 	"hotel-booking-system/internal/booking-srv/server"
 	"hotel-booking-system/internal/booking-srv/stg"
 	"hotel-booking-system/internal/kafka"
+	"hotel-booking-system/internal/package/apperr"
 	db "hotel-booking-system/internal/package/database"
+	"hotel-booking-system/internal/package/health"
+	"hotel-booking-system/internal/package/metrics"
+	"hotel-booking-system/internal/package/middleware"
+	"hotel-booking-system/internal/package/migrate"
+	"hotel-booking-system/internal/package/tracing"
+	"hotel-booking-system/migrations"
+	"hotel-booking-system/package/events"
+	bookingv1 "hotel-booking-system/package/proto/booking/stable"
 	hotelv1 "hotel-booking-system/package/proto/fast/stable"
 
 	"github.com/joho/godotenv"
@@ -30,70 +44,159 @@ func main() {
 		logrus.Warn("No .env file found, relying on environment variables")
 	}
 
-	hotelAddr := os.Getenv("HOTEL_SERVICE_ADDR")
-	if hotelAddr == "" {
-		hotelAddr = "localhost:50051"
+	cfg, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
 	}
-	conn, err := grpc.NewClient(hotelAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logrus.Fatalf("Invalid configuration: %v", err)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "booking-service")
+	if err != nil {
+		logrus.Fatalf("Failed to set up tracing: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logrus.Errorf("Failed to flush traces: %v", err)
+		}
+	}()
+
+	conn, err := grpc.NewClient(cfg.HotelAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(middleware.UnaryClientRequestID, metrics.UnaryClientInterceptor),
+	)
 	if err != nil {
 		logrus.Fatalf("Did not connect to hotel service: %v", err)
 	}
 	defer conn.Close()
-	hotelClient := hotelv1.NewHotelServiceClient(conn)
+	hotelCache := hotelclient.NewCache(hotelclient.New(hotelv1.NewHotelServiceClient(conn), cfg.HotelClient), cfg.HotelCache)
 
-	dbConfig := db.Config{
-		Host:     os.Getenv("DB_HOST"),
-		Port:     5432,
-		User:     os.Getenv("DB_USER"),
-		Password: os.Getenv("DB_PASS"),
-		DBName:   os.Getenv("DB_NAME"),
-		SSLMode:  "disable",
-	}
-	bookingDB, err := db.Connect(dbConfig)
+	bookingDB, err := db.Connect(cfg.Database.Connection())
 	if err != nil {
 		logrus.Fatalf("Error connecting to booking database: %v", err)
 	}
 	defer bookingDB.Close()
 
-	kafkaAddr := os.Getenv("KAFKA_BROKERS")
-	if kafkaAddr == "" {
-		kafkaAddr = "localhost:9091,localhost:9092,localhost:9093"
+	schema, err := migrate.Load(migrations.FS, "booking")
+	if err != nil {
+		logrus.Fatalf("Failed to load migrations: %v", err)
+	}
+	migrator := migrate.New(bookingDB, schema)
+	if len(cfg.Command) > 0 {
+		if cfg.Command[0] != "migrate" {
+			logrus.Fatalf("Unknown command %q", cfg.Command[0])
+		}
+		if err := migrate.Run(context.Background(), migrator, cfg.Command[1:], os.Stdout); err != nil {
+			logrus.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+	if cfg.MigrateOnStart {
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			logrus.Fatalf("Failed to migrate booking database: %v", err)
+		}
+		logrus.Infof("Applied %d migrations", applied)
+	}
+
+	// EVENT_CONTENT_TYPE=application/x-protobuf switches events to protobuf
+	// once every consumer understands the content-type header.
+	codec, err := events.CodecFor(cfg.Kafka.ContentType)
+	if err != nil {
+		logrus.Fatalf("Invalid EVENT_CONTENT_TYPE: %v", err)
 	}
-	producer, err := kafka.NewProducer(strings.Split(kafkaAddr, ","))
+	producer, err := kafka.NewProducer(cfg.Kafka.Brokers, codec)
 	if err != nil {
 		logrus.Fatalf("Failed to create kafka producer: %v", err)
 	}
 	defer producer.Close()
 
+	var invalidator *kafka.Consumer
+	if cfg.HotelCache.Enabled {
+		// Every replica keeps its own cache, so each one reads all the
+		// invalidation events in a consumer group of its own. A new replica
+		// starts with an empty cache and needs no events from before it.
+		hostname, _ := os.Hostname()
+		invalidator, err = kafka.NewConsumer(hotelclient.NewInvalidator(hotelCache), cfg.Kafka.Brokers,
+			hotelclient.InvalidationTopics, "booking-service-cache-"+hostname, kafka.OffsetLatest, 1)
+		if err != nil {
+			logrus.Fatalf("Failed to create hotel cache consumer: %v", err)
+		}
+		go invalidator.Start()
+	}
+
 	repo := repository.NewRepository(bookingDB)
 
-	storage := stg.NewStorage(repo, hotelClient, producer)
+	// Payments are approved locally until the payment service is connected.
+	storage := stg.NewStorage(repo, hotelCache, producer, stg.NoopPayments{})
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	go jobs.NewNoShowJob(storage, cfg.NoShowInterval).Start(jobsCtx)
+	go jobs.NewOutboxRelay(storage, cfg.OutboxRelayInterval).Start(jobsCtx)
+	go jobs.NewSagaRecovery(storage, cfg.SagaRecoveryInterval).Start(jobsCtx)
 
-	bookingServer := server.NewBookingServer(storage)
+	readiness := health.NewChecker()
+	readiness.Add("database", bookingDB.PingContext)
+	readiness.Add("kafka", producer.Ping)
+	readiness.Add("hotel-srv", health.GRPC(conn, ""))
+
+	bookingServer := server.NewBookingServer(storage, readiness)
 	bookingServer.SetServer()
 
-	httpPort := os.Getenv("HTTP_PORT")
-	if httpPort == "" {
-		httpPort = ":8080"
-	}
+	// Calendar imports are the largest bodies the service accepts.
+	const maxBodyBytes = 4 << 20
+	corsOrigins := middleware.Origins(cfg.CORSOrigins)
 
 	httpServer := &http.Server{
-		Addr:    httpPort,
-		Handler: bookingServer.Mux,
+		Addr:    cfg.HTTPAddr,
+		Handler: middleware.Chain(tracing.HTTP(metrics.HTTP(bookingServer.Mux)), middleware.Default(maxBodyBytes, corsOrigins)...),
 	}
 
 	go func() {
-		logrus.Infof("Starting booking server on %s", httpPort)
+		logrus.Infof("Starting booking server on %s", cfg.HTTPAddr)
 		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			logrus.Errorf("Server error: %v", err)
 		}
 	}()
 
+	grpcListener, err := net.Listen("tcp", cfg.GRPCAddr)
+	if err != nil {
+		logrus.Fatalf("Failed to listen on gRPC: %v", err)
+	}
+	grpcServer := grpc.NewServer(
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(
+			metrics.UnaryServerInterceptor,
+			middleware.UnaryServerRequestID,
+			apperr.UnaryServerInterceptor,
+			middleware.UnaryServerRecover,
+		),
+	)
+	bookingv1.RegisterBookingServiceServer(grpcServer, bookingServer)
+	go func() {
+		logrus.Infof("Starting booking gRPC server on %s", cfg.GRPCAddr)
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			logrus.Errorf("gRPC server error: %v", err)
+		}
+	}()
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
 	<-stop
 
 	logrus.Info("Shutting down...")
+	stopJobs()
+	if invalidator != nil {
+		if err := invalidator.Stop(); err != nil {
+			logrus.Errorf("Failed to stop hotel cache consumer: %v", err)
+		}
+	}
+	grpcServer.GracefulStop()
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
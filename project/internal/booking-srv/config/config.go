@@ -0,0 +1,99 @@
+// Package config holds the settings of booking-srv.
+package config
+
+import (
+	"errors"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/hotelclient"
+	"hotel-booking-system/internal/package/config"
+)
+
+type Config struct {
+	Database config.Database
+	Kafka    config.Kafka
+	// MigrateOnStart applies pending migrations before the service starts.
+	MigrateOnStart bool
+
+	HTTPAddr    string
+	GRPCAddr    string
+	CORSOrigins string
+
+	HotelAddr   string
+	HotelClient hotelclient.Config
+	HotelCache  hotelclient.CacheConfig
+
+	NoShowInterval       time.Duration
+	OutboxRelayInterval  time.Duration
+	SagaRecoveryInterval time.Duration
+
+	// Command holds the arguments after the flags, e.g. migrate up.
+	Command []string
+}
+
+func Default() *Config {
+	return &Config{
+		MigrateOnStart:       true,
+		Database:             config.DefaultDatabase(),
+		Kafka:                config.DefaultKafka(),
+		HTTPAddr:             ":8080",
+		GRPCAddr:             ":50052",
+		HotelAddr:            "localhost:50051",
+		HotelClient:          hotelclient.DefaultConfig(),
+		HotelCache:           hotelclient.DefaultCacheConfig(),
+		NoShowInterval:       15 * time.Minute,
+		OutboxRelayInterval:  time.Second,
+		SagaRecoveryInterval: 30 * time.Second,
+	}
+}
+
+// Load reads the booking section of the config file, the environment and
+// args and validates the result.
+func Load(args []string) (*Config, error) {
+	cfg := Default()
+	l := config.New("booking-srv")
+	l.Database(&cfg.Database, "booking")
+	l.Bool(&cfg.MigrateOnStart, "migrate-on-start", "MIGRATE_ON_START", "apply pending migrations at startup")
+	l.Kafka(&cfg.Kafka)
+	l.String(&cfg.HTTPAddr, "http-addr", "HTTP_PORT", "HTTP listen address")
+	l.String(&cfg.GRPCAddr, "grpc-addr", "GRPC_PORT", "gRPC listen address")
+	l.String(&cfg.CORSOrigins, "cors-origins", "CORS_ORIGINS", "comma-separated allowed CORS origins")
+
+	l.String(&cfg.HotelAddr, "hotel-addr", "HOTEL_SERVICE_ADDR", "hotel-srv gRPC address")
+	l.Duration(&cfg.HotelClient.Timeout, "hotel-call-timeout", "HOTEL_CALL_TIMEOUT", "deadline of one hotel-srv call")
+	l.Int(&cfg.HotelClient.MaxAttempts, "hotel-max-attempts", "HOTEL_MAX_ATTEMPTS", "attempts of an idempotent hotel-srv call")
+	l.Int(&cfg.HotelClient.BreakerFailures, "hotel-breaker-failures", "HOTEL_BREAKER_FAILURES", "failures in a row that open the circuit breaker")
+	l.Duration(&cfg.HotelClient.BreakerCooldown, "hotel-breaker-cooldown", "HOTEL_BREAKER_COOLDOWN", "how long the circuit breaker stays open")
+	l.Bool(&cfg.HotelCache.Enabled, "hotel-cache-enabled", "HOTEL_CACHE_ENABLED", "cache hotel prices and room lists")
+	l.Duration(&cfg.HotelCache.TTL, "hotel-cache-ttl", "HOTEL_CACHE_TTL", "lifetime of a hotel cache entry")
+
+	l.Duration(&cfg.NoShowInterval, "no-show-interval", "NO_SHOW_INTERVAL", "period of the no-show job")
+	l.Duration(&cfg.OutboxRelayInterval, "outbox-relay-interval", "OUTBOX_RELAY_INTERVAL", "period of the outbox relay")
+	l.Duration(&cfg.SagaRecoveryInterval, "saga-recovery-interval", "SAGA_RECOVERY_INTERVAL", "period of the booking saga recovery")
+
+	if err := l.Load(args); err != nil {
+		return nil, err
+	}
+	cfg.Command = l.Args()
+	return cfg, cfg.Validate()
+}
+
+func (c *Config) Validate() error {
+	errs := []error{c.Database.Validate(), c.Kafka.Validate()}
+	if c.HTTPAddr == "" || c.GRPCAddr == "" || c.HotelAddr == "" {
+		errs = append(errs, errors.New("HTTP, gRPC and hotel-srv addresses are required"))
+	}
+	if c.HotelClient.Timeout <= 0 {
+		errs = append(errs, errors.New("hotel call timeout must be positive"))
+	}
+	if c.HotelClient.MaxAttempts < 1 || c.HotelClient.BreakerFailures < 1 {
+		errs = append(errs, errors.New("hotel max attempts and breaker failures must be at least 1"))
+	}
+	if c.HotelCache.TTL <= 0 {
+		errs = append(errs, errors.New("hotel cache TTL must be positive"))
+	}
+	if c.NoShowInterval <= 0 || c.OutboxRelayInterval <= 0 || c.SagaRecoveryInterval <= 0 {
+		errs = append(errs, errors.New("job intervals must be positive"))
+	}
+	return errors.Join(errs...)
+}
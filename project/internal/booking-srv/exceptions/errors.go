@@ -1,10 +1,21 @@
 package exceptions
 
-import "errors"
+import "hotel-booking-system/internal/package/apperr"
 
 var (
-	ErrProblemsWithHotelManager = errors.New("there must be problems with hotel manager or your query is inaccurate")
-	ErrDates                    = errors.New("wrong dates")
-	ErrInsufficientFunds        = errors.New("insufficient funds")
-	ErrNotFound                 = errors.New("not found")
+	ErrProblemsWithHotelManager = apperr.New(apperr.Internal, "there must be problems with hotel manager or your query is inaccurate")
+	ErrDates                    = apperr.New(apperr.InvalidArgument, "wrong dates")
+	ErrInsufficientFunds        = apperr.New(apperr.FailedPrecondition, "insufficient funds")
+	ErrNotFound                 = apperr.New(apperr.NotFound, "record not found")
+	ErrInvalidRequest           = apperr.New(apperr.InvalidArgument, "invalid request")
+	ErrInvalidJSON              = apperr.New(apperr.InvalidArgument, "invalid json")
+	ErrRoomNotAvailable         = apperr.New(apperr.FailedPrecondition, "room is not available for the selected dates")
+	ErrBookingStatus            = apperr.New(apperr.FailedPrecondition, "booking status does not allow this")
+	ErrConcurrentChange         = apperr.New(apperr.Conflict, "booking was changed concurrently")
+	ErrNotBookingOwner          = apperr.New(apperr.PermissionDenied, "booking belongs to another user")
+	ErrPermissionDenied         = apperr.New(apperr.PermissionDenied, "permission denied")
+	ErrHotelNotFound            = apperr.New(apperr.NotFound, "hotel or room type not found")
+	ErrHotelRejected            = apperr.New(apperr.InvalidArgument, "hotel service rejected the request")
+	ErrHotelConflict            = apperr.New(apperr.Conflict, "hotel service refused the change")
+	ErrHotelUnavailable         = apperr.New(apperr.Unavailable, "hotel service is unavailable")
 )
@@ -0,0 +1,142 @@
+package hotelclient
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	hotelv1 "hotel-booking-system/package/proto/fast/stable"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+type CacheConfig struct {
+	// Enabled false passes every call straight through.
+	Enabled bool
+	// TTL bounds how long a missed invalidation event can serve stale data.
+	TTL time.Duration
+}
+
+func DefaultCacheConfig() CacheConfig {
+	return CacheConfig{
+		Enabled: true,
+		TTL:     5 * time.Minute,
+	}
+}
+
+type roomTypeKey struct {
+	hotelID    int32
+	roomTypeID int32
+}
+
+type cacheEntry struct {
+	resp    proto.Message
+	expires time.Time
+}
+
+// CacheStats counts lookups since the cache was created.
+type CacheStats struct {
+	Hits          uint64
+	Misses        uint64
+	Invalidations uint64
+}
+
+// Cache implements hotelv1.HotelServiceClient and keeps room prices and room
+// lists of a room type until they expire or Invalidate is called for it.
+// Other calls go straight to the next client.
+type Cache struct {
+	hotelv1.HotelServiceClient
+	cfg CacheConfig
+	now func() time.Time
+
+	mu     sync.Mutex
+	prices map[roomTypeKey]cacheEntry
+	rooms  map[roomTypeKey]cacheEntry
+	// generation changes on every invalidation, so that a response fetched
+	// before an invalidation is not stored after it.
+	generation uint64
+
+	hits, misses, invalidations atomic.Uint64
+}
+
+func NewCache(next hotelv1.HotelServiceClient, cfg CacheConfig) *Cache {
+	return &Cache{
+		HotelServiceClient: next,
+		cfg:                cfg,
+		now:                time.Now,
+		prices:             make(map[roomTypeKey]cacheEntry),
+		rooms:              make(map[roomTypeKey]cacheEntry),
+	}
+}
+
+func (c *Cache) GetRoomPrice(ctx context.Context, in *hotelv1.GetRoomPriceRequest, opts ...grpc.CallOption) (*hotelv1.GetRoomPriceResponse, error) {
+	key := roomTypeKey{in.GetHotelId(), in.GetRoomTypeId()}
+	resp, err := cached(c, c.prices, key, "GetRoomPrice", func() (*hotelv1.GetRoomPriceResponse, error) {
+		return c.HotelServiceClient.GetRoomPrice(ctx, in, opts...)
+	})
+	return resp, err
+}
+
+func (c *Cache) GetRoomsID(ctx context.Context, in *hotelv1.GetRoomsIDRequest, opts ...grpc.CallOption) (*hotelv1.GetRoomsIDResponse, error) {
+	key := roomTypeKey{in.GetHotelId(), in.GetRoomTypeId()}
+	resp, err := cached(c, c.rooms, key, "GetRoomsID", func() (*hotelv1.GetRoomsIDResponse, error) {
+		return c.HotelServiceClient.GetRoomsID(ctx, in, opts...)
+	})
+	return resp, err
+}
+
+// Invalidate drops the price and the room list of a room type.
+func (c *Cache) Invalidate(hotelID, roomTypeID int) {
+	key := roomTypeKey{int32(hotelID), int32(roomTypeID)}
+	c.mu.Lock()
+	delete(c.prices, key)
+	delete(c.rooms, key)
+	c.generation++
+	c.mu.Unlock()
+
+	c.invalidations.Add(1)
+	cacheInvalidations.Inc()
+}
+
+func (c *Cache) Stats() CacheStats {
+	return CacheStats{
+		Hits:          c.hits.Load(),
+		Misses:        c.misses.Load(),
+		Invalidations: c.invalidations.Load(),
+	}
+}
+
+// cached returns a copy of the stored response for key or fetches and stores
+// it. Errors are not cached.
+func cached[T proto.Message](c *Cache, entries map[roomTypeKey]cacheEntry, key roomTypeKey, method string, fetch func() (T, error)) (T, error) {
+	if !c.cfg.Enabled {
+		cacheRequests.WithLabelValues(method, "bypass").Inc()
+		return fetch()
+	}
+
+	c.mu.Lock()
+	entry, ok := entries[key]
+	generation := c.generation
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expires) {
+		c.hits.Add(1)
+		cacheRequests.WithLabelValues(method, "hit").Inc()
+		return proto.Clone(entry.resp).(T), nil
+	}
+
+	c.misses.Add(1)
+	cacheRequests.WithLabelValues(method, "miss").Inc()
+	resp, err := fetch()
+	if err != nil {
+		return resp, err
+	}
+
+	c.mu.Lock()
+	if c.generation == generation {
+		entries[key] = cacheEntry{resp: proto.Clone(resp), expires: c.now().Add(c.cfg.TTL)}
+	}
+	c.mu.Unlock()
+	return resp, nil
+}
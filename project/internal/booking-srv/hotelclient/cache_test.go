@@ -0,0 +1,120 @@
+package hotelclient
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"hotel-booking-system/package/events"
+	hotelv1 "hotel-booking-system/package/proto/fast/stable"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	fake := &fakeHotel{}
+	cache := NewCache(fake, CacheConfig{Enabled: true, TTL: time.Minute})
+	cache.now = func() time.Time { return now }
+
+	price := func(hotelID int32) {
+		t.Helper()
+		resp, err := cache.GetRoomPrice(context.Background(), &hotelv1.GetRoomPriceRequest{HotelId: hotelID, RoomTypeId: 1})
+		if err != nil || resp.GetPrice() != 100 {
+			t.Fatalf("got %v, %v", resp, err)
+		}
+		// Callers own the response they get.
+		resp.Price = 0
+	}
+
+	price(1)
+	price(1)
+	price(2)
+	if fake.calls != 2 {
+		t.Fatalf("%d calls, want 2", fake.calls)
+	}
+
+	rooms, err := cache.GetRoomsID(context.Background(), &hotelv1.GetRoomsIDRequest{HotelId: 1, RoomTypeId: 1})
+	if err != nil || len(rooms.GetRoomIds()) != 2 {
+		t.Fatalf("got %v, %v", rooms, err)
+	}
+	if fake.calls != 3 {
+		t.Fatalf("room list served from the price entry")
+	}
+
+	cache.Invalidate(1, 1)
+	price(1)
+	price(2)
+	if fake.calls != 4 {
+		t.Errorf("%d calls after invalidation, want 4", fake.calls)
+	}
+
+	now = now.Add(time.Minute)
+	price(2)
+	if fake.calls != 5 {
+		t.Errorf("expired entry served")
+	}
+
+	want := CacheStats{Hits: 2, Misses: 5, Invalidations: 1}
+	if got := cache.Stats(); got != want {
+		t.Errorf("stats %+v, want %+v", got, want)
+	}
+}
+
+func TestCacheSkipsErrors(t *testing.T) {
+	fake := &fakeHotel{errs: []error{status.Error(codes.NotFound, "room type not found")}}
+	cache := NewCache(fake, DefaultCacheConfig())
+
+	for range 2 {
+		_, _ = cache.GetRoomPrice(context.Background(), &hotelv1.GetRoomPriceRequest{HotelId: 1, RoomTypeId: 1})
+	}
+	if fake.calls != 2 {
+		t.Errorf("%d calls, want 2: an error was cached", fake.calls)
+	}
+}
+
+func TestCacheDisabled(t *testing.T) {
+	fake := &fakeHotel{}
+	cache := NewCache(fake, CacheConfig{Enabled: false, TTL: time.Minute})
+
+	for range 2 {
+		_, _ = cache.GetRoomPrice(context.Background(), &hotelv1.GetRoomPriceRequest{HotelId: 1, RoomTypeId: 1})
+	}
+	if fake.calls != 2 {
+		t.Errorf("%d calls, want 2", fake.calls)
+	}
+	if stats := cache.Stats(); stats.Hits+stats.Misses != 0 {
+		t.Errorf("bypassed calls counted: %+v", stats)
+	}
+}
+
+func TestInvalidator(t *testing.T) {
+	fake := &fakeHotel{}
+	cache := NewCache(fake, DefaultCacheConfig())
+	invalidator := NewInvalidator(cache)
+
+	for _, event := range []events.Event{
+		events.RoomPriceChangedEvent{HotelID: 1, RoomTypeID: 1, Price: 120},
+		events.RoomsChangedEvent{HotelID: 1, RoomTypeID: 1, RoomIDs: []int{1, 2, 3}},
+	} {
+		_, _ = cache.GetRoomPrice(context.Background(), &hotelv1.GetRoomPriceRequest{HotelId: 1, RoomTypeId: 1})
+		calls := fake.calls
+
+		env, err := events.NewEnvelope("hotel-srv", event, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		message, _ := json.Marshal(env)
+		if err := invalidator.HandleMessage(context.Background(), message, kafka.TopicPartition{}, 1); err != nil {
+			t.Fatalf("HandleMessage: %v", err)
+		}
+
+		_, _ = cache.GetRoomPrice(context.Background(), &hotelv1.GetRoomPriceRequest{HotelId: 1, RoomTypeId: 1})
+		if fake.calls != calls+1 {
+			t.Errorf("%s did not invalidate the cache", event.EventType())
+		}
+	}
+}
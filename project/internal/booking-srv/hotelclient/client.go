@@ -0,0 +1,188 @@
+// Package hotelclient wraps the hotel-srv gRPC client with a deadline per
+// call, retries with jittered backoff for idempotent RPCs and a circuit
+// breaker that fails fast while hotel-srv is degraded.
+package hotelclient
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"hotel-booking-system/internal/package/breaker"
+	hotelv1 "hotel-booking-system/package/proto/fast/stable"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Config struct {
+	// Timeout bounds every attempt.
+	Timeout time.Duration
+	// MaxAttempts counts the first call; only idempotent RPCs are retried.
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// BreakerFailures consecutive failures open the breaker for
+	// BreakerCooldown.
+	BreakerFailures int
+	BreakerCooldown time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Timeout:         3 * time.Second,
+		MaxAttempts:     3,
+		BaseBackoff:     100 * time.Millisecond,
+		MaxBackoff:      time.Second,
+		BreakerFailures: 5,
+		BreakerCooldown: 30 * time.Second,
+	}
+}
+
+// Client implements hotelv1.HotelServiceClient on top of another client.
+type Client struct {
+	next    hotelv1.HotelServiceClient
+	cfg     Config
+	breaker *breaker.Breaker
+}
+
+func New(next hotelv1.HotelServiceClient, cfg Config) *Client {
+	breakerState.Set(float64(breaker.Closed))
+	return &Client{
+		next: next,
+		cfg:  cfg,
+		breaker: breaker.New(cfg.BreakerFailures, cfg.BreakerCooldown, func(from, to breaker.State) {
+			logrus.Warnf("Hotel service circuit breaker %s -> %s", from, to)
+			breakerState.Set(float64(to))
+		}),
+	}
+}
+
+// State returns the state of the circuit breaker.
+func (c *Client) State() breaker.State {
+	return c.breaker.State()
+}
+
+func (c *Client) GetRoomPrice(ctx context.Context, in *hotelv1.GetRoomPriceRequest, opts ...grpc.CallOption) (*hotelv1.GetRoomPriceResponse, error) {
+	var resp *hotelv1.GetRoomPriceResponse
+	err := c.call(ctx, "GetRoomPrice", true, func(ctx context.Context) (err error) {
+		resp, err = c.next.GetRoomPrice(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *Client) GetRoomsID(ctx context.Context, in *hotelv1.GetRoomsIDRequest, opts ...grpc.CallOption) (*hotelv1.GetRoomsIDResponse, error) {
+	var resp *hotelv1.GetRoomsIDResponse
+	err := c.call(ctx, "GetRoomsID", true, func(ctx context.Context) (err error) {
+		resp, err = c.next.GetRoomsID(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *Client) GetHotelDetails(ctx context.Context, in *hotelv1.GetHotelDetailsRequest, opts ...grpc.CallOption) (*hotelv1.GetHotelDetailsResponse, error) {
+	var resp *hotelv1.GetHotelDetailsResponse
+	err := c.call(ctx, "GetHotelDetails", true, func(ctx context.Context) (err error) {
+		resp, err = c.next.GetHotelDetails(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+// CreateReview is not retried: a lost response does not tell whether the
+// review was stored.
+func (c *Client) CreateReview(ctx context.Context, in *hotelv1.CreateReviewRequest, opts ...grpc.CallOption) (*hotelv1.CreateReviewResponse, error) {
+	var resp *hotelv1.CreateReviewResponse
+	err := c.call(ctx, "CreateReview", false, func(ctx context.Context) (err error) {
+		resp, err = c.next.CreateReview(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+// call runs attempt through the breaker with a deadline, retrying idempotent
+// calls that failed with a transient code while ctx is alive. A rejected
+// call fails with Unavailable, like a hotel-srv that is down.
+func (c *Client) call(ctx context.Context, method string, idempotent bool, attempt func(context.Context) error) error {
+	attempts := 1
+	if idempotent {
+		attempts = max(c.cfg.MaxAttempts, 1)
+	}
+
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			retries.WithLabelValues(method).Inc()
+			if !sleep(ctx, c.backoff(i)) {
+				return err
+			}
+		}
+
+		if c.breaker.Allow() != nil {
+			rejected.WithLabelValues(method).Inc()
+			return status.Errorf(codes.Unavailable, "hotel service %s: %v", method, breaker.ErrOpen)
+		}
+		attemptCtx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+		err = attempt(attemptCtx)
+		cancel()
+
+		// Calls the caller gave up on say nothing about hotel-srv, neither
+		// that it has recovered nor that it is failing.
+		if ctx.Err() != nil {
+			c.breaker.Release()
+			return err
+		}
+		c.breaker.Record(isFailure(err))
+		if err == nil || !retryable(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// backoff doubles from BaseBackoff up to MaxBackoff and picks a random
+// duration in the upper half, so that callers do not retry in lockstep.
+func (c *Client) backoff(retry int) time.Duration {
+	d := c.cfg.BaseBackoff << (retry - 1)
+	if d > c.cfg.MaxBackoff || d <= 0 {
+		d = c.cfg.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// isFailure tells errors of a degraded hotel-srv from answers such as
+// NotFound or InvalidArgument.
+func isFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
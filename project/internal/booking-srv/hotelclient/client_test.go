@@ -0,0 +1,173 @@
+package hotelclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"hotel-booking-system/internal/package/breaker"
+	hotelv1 "hotel-booking-system/package/proto/fast/stable"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeHotel answers calls with the queued errors, then succeeds.
+type fakeHotel struct {
+	hotelv1.HotelServiceClient
+	errs      []error
+	calls     int
+	deadlines []bool
+	// onCall, if set, runs at the start of every call.
+	onCall func()
+}
+
+func (f *fakeHotel) next(ctx context.Context) error {
+	f.calls++
+	if f.onCall != nil {
+		f.onCall()
+	}
+	_, ok := ctx.Deadline()
+	f.deadlines = append(f.deadlines, ok)
+	if len(f.errs) == 0 {
+		return nil
+	}
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+	return err
+}
+
+func (f *fakeHotel) GetRoomPrice(ctx context.Context, _ *hotelv1.GetRoomPriceRequest, _ ...grpc.CallOption) (*hotelv1.GetRoomPriceResponse, error) {
+	if err := f.next(ctx); err != nil {
+		return nil, err
+	}
+	return &hotelv1.GetRoomPriceResponse{Price: 100}, nil
+}
+
+func (f *fakeHotel) GetRoomsID(ctx context.Context, _ *hotelv1.GetRoomsIDRequest, _ ...grpc.CallOption) (*hotelv1.GetRoomsIDResponse, error) {
+	if err := f.next(ctx); err != nil {
+		return nil, err
+	}
+	return &hotelv1.GetRoomsIDResponse{RoomIds: []int32{1, 2}}, nil
+}
+
+func (f *fakeHotel) CreateReview(ctx context.Context, _ *hotelv1.CreateReviewRequest, _ ...grpc.CallOption) (*hotelv1.CreateReviewResponse, error) {
+	if err := f.next(ctx); err != nil {
+		return nil, err
+	}
+	return &hotelv1.CreateReviewResponse{}, nil
+}
+
+func testConfig() Config {
+	cfg := DefaultConfig()
+	cfg.BaseBackoff = time.Millisecond
+	cfg.MaxBackoff = 2 * time.Millisecond
+	return cfg
+}
+
+func TestRetriesIdempotentCalls(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	fake := &fakeHotel{errs: []error{unavailable, unavailable}}
+	client := New(fake, testConfig())
+
+	resp, err := client.GetRoomPrice(context.Background(), &hotelv1.GetRoomPriceRequest{})
+	if err != nil || resp.GetPrice() != 100 {
+		t.Fatalf("got %v, %v", resp, err)
+	}
+	if fake.calls != 3 {
+		t.Errorf("%d calls, want 3", fake.calls)
+	}
+	for i, ok := range fake.deadlines {
+		if !ok {
+			t.Errorf("attempt %d has no deadline", i+1)
+		}
+	}
+}
+
+func TestDoesNotRetry(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(*Client) error
+		err  error
+	}{
+		{"not found", func(c *Client) error {
+			_, err := c.GetRoomPrice(context.Background(), &hotelv1.GetRoomPriceRequest{})
+			return err
+		}, status.Error(codes.NotFound, "room type not found")},
+		{"create review", func(c *Client) error {
+			_, err := c.CreateReview(context.Background(), &hotelv1.CreateReviewRequest{})
+			return err
+		}, status.Error(codes.Unavailable, "connection reset")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeHotel{errs: []error{tt.err}}
+			if err := tt.call(New(fake, testConfig())); status.Code(err) != status.Code(tt.err) {
+				t.Errorf("got %v, want %v", err, tt.err)
+			}
+			if fake.calls != 1 {
+				t.Errorf("%d calls, want 1", fake.calls)
+			}
+		})
+	}
+}
+
+func TestBreakerFailsFast(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxAttempts = 1
+	cfg.BreakerFailures = 2
+	fake := &fakeHotel{errs: []error{
+		status.Error(codes.Unavailable, "down"),
+		status.Error(codes.DeadlineExceeded, "slow"),
+	}}
+	client := New(fake, cfg)
+
+	for range 2 {
+		_, _ = client.GetRoomPrice(context.Background(), &hotelv1.GetRoomPriceRequest{})
+	}
+	if client.State() != breaker.Open {
+		t.Fatalf("state %s, want open", client.State())
+	}
+
+	_, err := client.GetRoomPrice(context.Background(), &hotelv1.GetRoomPriceRequest{})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("got %v, want Unavailable", err)
+	}
+	if fake.calls != 2 {
+		t.Errorf("open breaker let a call through")
+	}
+}
+
+func TestCancelledProbeKeepsBreakerHalfOpen(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxAttempts = 1
+	cfg.BreakerFailures = 1
+	cfg.BreakerCooldown = 0
+	fake := &fakeHotel{errs: []error{status.Error(codes.Unavailable, "down")}}
+	client := New(fake, cfg)
+
+	_, _ = client.GetRoomPrice(context.Background(), &hotelv1.GetRoomPriceRequest{})
+	if client.State() != breaker.Open {
+		t.Fatalf("state %s, want open", client.State())
+	}
+
+	// The caller goes away while the probe is in flight.
+	ctx, cancel := context.WithCancel(context.Background())
+	fake.onCall = cancel
+	fake.errs = []error{status.Error(codes.Canceled, "context canceled")}
+	if _, err := client.GetRoomPrice(ctx, &hotelv1.GetRoomPriceRequest{}); status.Code(err) != codes.Canceled {
+		t.Fatalf("got %v, want Canceled", err)
+	}
+	if client.State() != breaker.HalfOpen {
+		t.Fatalf("state %s after a cancelled probe, want half-open", client.State())
+	}
+
+	fake.onCall = nil
+	if _, err := client.GetRoomPrice(context.Background(), &hotelv1.GetRoomPriceRequest{}); err != nil {
+		t.Fatalf("next probe: %v", err)
+	}
+	if client.State() != breaker.Closed {
+		t.Errorf("state %s after a successful probe, want closed", client.State())
+	}
+}
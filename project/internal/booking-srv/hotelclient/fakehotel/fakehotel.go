@@ -0,0 +1,161 @@
+// Package fakehotel implements hotelv1.HotelServiceClient in memory for
+// tests of booking-srv.
+package fakehotel
+
+import (
+	"context"
+	"sync"
+
+	hotelv1 "hotel-booking-system/package/proto/fast/stable"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Method names accepted by Fail and Calls.
+const (
+	GetRoomPrice    = "GetRoomPrice"
+	GetRoomsID      = "GetRoomsID"
+	GetHotelDetails = "GetHotelDetails"
+	CreateReview    = "CreateReview"
+)
+
+// RoomType is a room type of a hotel together with its rooms.
+type RoomType struct {
+	HotelID   int
+	ID        int
+	HotelName string
+	Address   string
+	Phone     string
+	Name      string
+	Price     float64
+	RoomIDs   []int
+}
+
+type key struct {
+	hotelID, roomTypeID int
+}
+
+// Client answers like hotel-srv does: unknown room types are NotFound, a
+// second review of a booking is AlreadyExists. It is safe for concurrent
+// use.
+type Client struct {
+	mu        sync.Mutex
+	roomTypes map[key]RoomType
+	reviews   []*hotelv1.CreateReviewRequest
+	failures  map[string]error
+	calls     map[string]int
+}
+
+func New() *Client {
+	return &Client{
+		roomTypes: make(map[key]RoomType),
+		failures:  make(map[string]error),
+		calls:     make(map[string]int),
+	}
+}
+
+// AddRoomType stores or replaces a room type.
+func (c *Client) AddRoomType(rt RoomType) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.roomTypes[key{rt.HotelID, rt.ID}] = rt
+}
+
+// Fail makes every call of method return err until it is called again with
+// a nil err.
+func (c *Client) Fail(method string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err == nil {
+		delete(c.failures, method)
+		return
+	}
+	c.failures[method] = err
+}
+
+// Calls returns how many times method has been called.
+func (c *Client) Calls(method string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls[method]
+}
+
+// Reviews returns the reviews created so far.
+func (c *Client) Reviews() []*hotelv1.CreateReviewRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*hotelv1.CreateReviewRequest(nil), c.reviews...)
+}
+
+func (c *Client) GetRoomPrice(ctx context.Context, in *hotelv1.GetRoomPriceRequest, _ ...grpc.CallOption) (*hotelv1.GetRoomPriceResponse, error) {
+	rt, err := c.roomType(GetRoomPrice, in.GetHotelId(), in.GetRoomTypeId())
+	if err != nil {
+		return nil, err
+	}
+	return &hotelv1.GetRoomPriceResponse{Price: rt.Price, Currency: "RUB"}, nil
+}
+
+func (c *Client) GetRoomsID(ctx context.Context, in *hotelv1.GetRoomsIDRequest, _ ...grpc.CallOption) (*hotelv1.GetRoomsIDResponse, error) {
+	rt, err := c.roomType(GetRoomsID, in.GetHotelId(), in.GetRoomTypeId())
+	if status.Code(err) == codes.NotFound {
+		return &hotelv1.GetRoomsIDResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := &hotelv1.GetRoomsIDResponse{}
+	for _, id := range rt.RoomIDs {
+		resp.RoomIds = append(resp.RoomIds, int32(id))
+	}
+	return resp, nil
+}
+
+func (c *Client) GetHotelDetails(ctx context.Context, in *hotelv1.GetHotelDetailsRequest, _ ...grpc.CallOption) (*hotelv1.GetHotelDetailsResponse, error) {
+	rt, err := c.roomType(GetHotelDetails, in.GetHotelId(), in.GetRoomTypeId())
+	if err != nil {
+		return nil, err
+	}
+	return &hotelv1.GetHotelDetailsResponse{
+		HotelName:    rt.HotelName,
+		Address:      rt.Address,
+		ContactPhone: rt.Phone,
+		RoomTypeName: rt.Name,
+	}, nil
+}
+
+func (c *Client) CreateReview(ctx context.Context, in *hotelv1.CreateReviewRequest, _ ...grpc.CallOption) (*hotelv1.CreateReviewResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(CreateReview); err != nil {
+		return nil, err
+	}
+	for _, r := range c.reviews {
+		if r.BookingId == in.BookingId {
+			return nil, status.Errorf(codes.AlreadyExists, "review for booking %d already exists", in.BookingId)
+		}
+	}
+	c.reviews = append(c.reviews, in)
+	return &hotelv1.CreateReviewResponse{ReviewId: int32(len(c.reviews))}, nil
+}
+
+func (c *Client) roomType(method string, hotelID, roomTypeID int32) (RoomType, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(method); err != nil {
+		return RoomType{}, err
+	}
+	rt, ok := c.roomTypes[key{int(hotelID), int(roomTypeID)}]
+	if !ok {
+		return RoomType{}, status.Errorf(codes.NotFound, "room type %d not found in hotel %d", roomTypeID, hotelID)
+	}
+	return rt, nil
+}
+
+func (c *Client) call(method string) error {
+	c.calls[method]++
+	return c.failures[method]
+}
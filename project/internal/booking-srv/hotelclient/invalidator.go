@@ -0,0 +1,49 @@
+package hotelclient
+
+import (
+	"context"
+	"encoding/json"
+
+	"hotel-booking-system/package/events"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/sirupsen/logrus"
+)
+
+// InvalidationTopics are the hotel-srv events that change cached data.
+var InvalidationTopics = []string{events.TypeRoomPriceChanged, events.TypeRoomsChanged}
+
+// Invalidator is a kafka.Handler that drops the room types named by hotel-srv
+// change events from the cache.
+type Invalidator struct {
+	cache *Cache
+}
+
+func NewInvalidator(cache *Cache) *Invalidator {
+	return &Invalidator{cache: cache}
+}
+
+// HandleMessage never fails: a message it cannot read is logged and skipped,
+// the TTL bounds how long the cache stays stale.
+func (i *Invalidator) HandleMessage(_ context.Context, message []byte, _ kafka.TopicPartition, _ int) error {
+	var env events.Envelope
+	if err := json.Unmarshal(message, &env); err != nil {
+		logrus.Errorf("Failed to parse event JSON: %v", err)
+		return nil
+	}
+	event, err := env.Decode()
+	if err != nil {
+		logrus.Errorf("Failed to decode event %s: %v", env.EventID, err)
+		return nil
+	}
+
+	switch e := event.(type) {
+	case *events.RoomPriceChangedEvent:
+		i.cache.Invalidate(e.HotelID, e.RoomTypeID)
+	case *events.RoomsChangedEvent:
+		i.cache.Invalidate(e.HotelID, e.RoomTypeID)
+	default:
+		logrus.Warnf("Skipping unsupported event %s v%d", env.Type, env.SchemaVersion)
+	}
+	return nil
+}
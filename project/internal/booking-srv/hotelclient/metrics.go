@@ -0,0 +1,33 @@
+package hotelclient
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	breakerState = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "hotel_client_circuit_state",
+		Help: "State of the hotel service circuit breaker: 0 closed, 1 half-open, 2 open.",
+	})
+
+	retries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "hotel_client_retries_total",
+		Help: "Retried hotel service calls by method.",
+	}, []string{"method"})
+
+	rejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "hotel_client_rejected_total",
+		Help: "Hotel service calls failed fast by the open circuit breaker, by method.",
+	}, []string{"method"})
+
+	cacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "hotel_cache_requests_total",
+		Help: "Cached hotel service lookups by method and result: hit, miss or bypass.",
+	}, []string{"method", "result"})
+
+	cacheInvalidations = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hotel_cache_invalidations_total",
+		Help: "Room types dropped from the hotel cache on hotel-srv events.",
+	})
+)
@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/stg"
+	"hotel-booking-system/internal/package/requestctx"
+
+	"github.com/sirupsen/logrus"
+)
+
+// noShowActor attributes the job's changes in the booking history.
+const noShowActor = "system:no-show-job"
+
+type NoShowJob struct {
+	storage  *stg.Storage
+	interval time.Duration
+}
+
+func NewNoShowJob(storage *stg.Storage, interval time.Duration) *NoShowJob {
+	return &NoShowJob{
+		storage:  storage,
+		interval: interval,
+	}
+}
+
+func (j *NoShowJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *NoShowJob) run(ctx context.Context) {
+	ctx = requestctx.WithActor(ctx, noShowActor)
+	ctx = requestctx.WithRequestID(ctx, requestctx.NewRequestID())
+
+	processed, err := j.storage.ProcessNoShows(ctx, time.Now())
+	if err != nil {
+		logrus.Errorf("No-show job failed: %v", err)
+		return
+	}
+	if processed > 0 {
+		logrus.Infof("No-show job marked %d bookings", processed)
+	}
+}
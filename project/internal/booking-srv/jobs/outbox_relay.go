@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/stg"
+
+	"github.com/sirupsen/logrus"
+)
+
+const outboxBatchSize = 100
+
+type OutboxRelay struct {
+	storage  *stg.Storage
+	interval time.Duration
+}
+
+func NewOutboxRelay(storage *stg.Storage, interval time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		storage:  storage,
+		interval: interval,
+	}
+}
+
+func (j *OutboxRelay) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// run drains the outbox in batches until a batch comes back short, so that a
+// backlog built up during a Kafka outage is flushed without waiting for
+// further ticks.
+func (j *OutboxRelay) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		sent, err := j.storage.RelayOutbox(ctx, outboxBatchSize)
+		if err != nil {
+			logrus.Errorf("Outbox relay failed: %v", err)
+			return
+		}
+		if sent < outboxBatchSize {
+			return
+		}
+	}
+}
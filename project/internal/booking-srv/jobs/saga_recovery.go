@@ -0,0 +1,57 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/stg"
+
+	"github.com/sirupsen/logrus"
+)
+
+const sagaBatchSize = 20
+
+// SagaRecovery resumes create-booking sagas left unfinished by a stopped
+// booking-srv instance.
+type SagaRecovery struct {
+	storage  *stg.Storage
+	interval time.Duration
+}
+
+func NewSagaRecovery(storage *stg.Storage, interval time.Duration) *SagaRecovery {
+	return &SagaRecovery{
+		storage:  storage,
+		interval: interval,
+	}
+}
+
+func (j *SagaRecovery) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *SagaRecovery) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		resumed, err := j.storage.ResumeSagas(ctx, sagaBatchSize)
+		if err != nil {
+			logrus.Errorf("Saga recovery failed: %v", err)
+			return
+		}
+		if resumed > 0 {
+			logrus.Infof("Saga recovery resumed %d sagas", resumed)
+		}
+		if resumed < sagaBatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"hotel-booking-system/internal/package/requestctx"
+)
+
+const (
+	ActionCreated      = "created"
+	ActionModified     = "modified"
+	ActionConfirmed    = StatusConfirmed
+	ActionCheckedIn    = StatusCheckedIn
+	ActionCheckedOut   = StatusCheckedOut
+	ActionCancelled    = StatusCancelled
+	ActionNoShow       = StatusNoShow
+	ActionHoldReleased = "hold_released"
+)
+
+type BookingEvent struct {
+	ID        int64           `json:"id"`
+	BookingID int             `json:"booking_id"`
+	Action    string          `json:"action"`
+	Actor     string          `json:"actor"`
+	RequestID string          `json:"request_id"`
+	Before    json.RawMessage `json:"before"`
+	After     json.RawMessage `json:"after"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// mutateBooking locks the booking row, lets apply change it, records the
+// before and after snapshots in booking_events and queues the event built by
+// newEvent in the outbox, all in the same transaction. apply returns false to
+// leave the booking untouched. A nil newEvent records the change without
+// publishing anything.
+func (r *Repository) mutateBooking(ctx context.Context, bookingID int, action string, apply func(tx *sql.Tx, before *Booking) (bool, error), newEvent ChangeEvent) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	before, err := getBookingForUpdate(ctx, tx, bookingID)
+	if err != nil {
+		return false, err
+	}
+
+	changed, err := apply(tx, before)
+	if err != nil || !changed {
+		return false, err
+	}
+
+	after, err := getBookingForUpdate(ctx, tx, bookingID)
+	if err != nil {
+		return false, err
+	}
+	if err := recordBookingEvent(ctx, tx, bookingID, action, before, after); err != nil {
+		return false, err
+	}
+
+	if newEvent != nil {
+		msg, err := newEvent(before, after)
+		if err != nil {
+			return false, err
+		}
+		if err := enqueueOutbox(ctx, tx, msg); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+func getBookingForUpdate(ctx context.Context, tx *sql.Tx, bookingID int) (*Booking, error) {
+	query := `
+		SELECT id, user_id, hotel_id, room_id, check_in_date, check_out_date,
+		       guests_count, total_price, loyalty_discount, status,
+		       hotel_name, hotel_address, hotel_phone, room_type_name
+		FROM bookings
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	var b Booking
+	err := tx.QueryRowContext(ctx, query, bookingID).Scan(
+		&b.ID,
+		&b.UserID,
+		&b.HotelID,
+		&b.RoomID,
+		&b.CheckInDate,
+		&b.CheckOutDate,
+		&b.GuestsCount,
+		&b.TotalPrice,
+		&b.LoyaltyDiscount,
+		&b.Status,
+		&b.HotelName,
+		&b.HotelAddress,
+		&b.HotelPhone,
+		&b.RoomTypeName,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking: %w", err)
+	}
+
+	return &b, nil
+}
+
+// recordBookingEvent takes the actor and request ID from ctx. A nil snapshot
+// is stored as NULL.
+func recordBookingEvent(ctx context.Context, tx *sql.Tx, bookingID int, action string, before, after *Booking) error {
+	beforeJSON, err := snapshot(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := snapshot(after)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO booking_events (booking_id, action, actor, request_id, before, after)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err = tx.ExecContext(ctx, query,
+		bookingID,
+		action,
+		requestctx.Actor(ctx),
+		requestctx.RequestID(ctx),
+		beforeJSON,
+		afterJSON,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record booking event: %w", err)
+	}
+	return nil
+}
+
+func snapshot(b *Booking) (sql.NullString, error) {
+	if b == nil {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(b)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to marshal booking snapshot: %w", err)
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+func (r *Repository) GetBookingEvents(ctx context.Context, bookingID int) ([]BookingEvent, error) {
+	query := `
+		SELECT id, booking_id, action, actor, request_id, before, after, created_at
+		FROM booking_events
+		WHERE booking_id = $1
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query booking events: %w", err)
+	}
+	defer rows.Close()
+
+	var history []BookingEvent
+	for rows.Next() {
+		var e BookingEvent
+		var before, after []byte
+		if err := rows.Scan(&e.ID, &e.BookingID, &e.Action, &e.Actor, &e.RequestID, &before, &after, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan booking event: %w", err)
+		}
+		if before != nil {
+			e.Before = before
+		}
+		if after != nil {
+			e.After = after
+		}
+		history = append(history, e)
+	}
+
+	return history, nil
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type RoomBlock struct {
+	ID          int       `json:"id"`
+	RoomID      int       `json:"room_id"`
+	StartsAt    time.Time `json:"starts_at"`
+	EndsAt      time.Time `json:"ends_at"`
+	Source      string    `json:"source"`
+	ExternalUID string    `json:"external_uid"`
+	Summary     string    `json:"summary"`
+}
+
+// SaveCalendarToken stores the user's feed token, replacing any previous one
+// so that old feed URLs stop working.
+func (r *Repository) SaveCalendarToken(ctx context.Context, userID int, token string) error {
+	query := `
+		INSERT INTO calendar_tokens (user_id, token)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET token = EXCLUDED.token, created_at = NOW()
+	`
+	if _, err := r.db.ExecContext(ctx, query, userID, token); err != nil {
+		return fmt.Errorf("failed to save calendar token: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetUserIDByCalendarToken(ctx context.Context, token string) (int, error) {
+	var userID int
+	err := r.db.QueryRowContext(ctx, `SELECT user_id FROM calendar_tokens WHERE token = $1`, token).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get calendar token: %w", err)
+	}
+	return userID, nil
+}
+
+func (r *Repository) GetRoomBookings(ctx context.Context, roomID int) ([]Booking, error) {
+	query := `
+		SELECT id, user_id, hotel_id, room_id, check_in_date, check_out_date,
+		       guests_count, total_price, loyalty_discount, status,
+		       hotel_name, hotel_address, hotel_phone, room_type_name
+		FROM bookings
+		WHERE room_id = $1 AND status NOT IN ('cancelled', 'no_show')
+		ORDER BY check_in_date
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query room bookings: %w", err)
+	}
+	defer rows.Close()
+
+	var bookings []Booking
+	for rows.Next() {
+		var b Booking
+		err := rows.Scan(
+			&b.ID,
+			&b.UserID,
+			&b.HotelID,
+			&b.RoomID,
+			&b.CheckInDate,
+			&b.CheckOutDate,
+			&b.GuestsCount,
+			&b.TotalPrice,
+			&b.LoyaltyDiscount,
+			&b.Status,
+			&b.HotelName,
+			&b.HotelAddress,
+			&b.HotelPhone,
+			&b.RoomTypeName,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan booking: %w", err)
+		}
+		bookings = append(bookings, b)
+	}
+
+	return bookings, nil
+}
+
+func (r *Repository) GetRoomBlocks(ctx context.Context, roomID int) ([]RoomBlock, error) {
+	query := `
+		SELECT id, room_id, starts_at, ends_at, source, external_uid, summary
+		FROM room_blocks
+		WHERE room_id = $1
+		ORDER BY starts_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query room blocks: %w", err)
+	}
+	defer rows.Close()
+
+	var blocks []RoomBlock
+	for rows.Next() {
+		var b RoomBlock
+		if err := rows.Scan(&b.ID, &b.RoomID, &b.StartsAt, &b.EndsAt, &b.Source, &b.ExternalUID, &b.Summary); err != nil {
+			return nil, fmt.Errorf("failed to scan room block: %w", err)
+		}
+		blocks = append(blocks, b)
+	}
+
+	return blocks, nil
+}
+
+// ReplaceRoomBlocks makes the blocks imported from source for the room match
+// blocks exactly, dropping entries that disappeared from the external feed.
+func (r *Repository) ReplaceRoomBlocks(ctx context.Context, roomID int, source string, blocks []RoomBlock) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to replace room blocks: %w", err)
+	}
+	defer tx.Rollback()
+
+	// New blocks must not slip in between a booking's availability check and
+	// its insert.
+	if err := lockRoom(ctx, tx, roomID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM room_blocks WHERE room_id = $1 AND source = $2`, roomID, source); err != nil {
+		return fmt.Errorf("failed to replace room blocks: %w", err)
+	}
+
+	query := `
+		INSERT INTO room_blocks (room_id, starts_at, ends_at, source, external_uid, summary)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (room_id, source, external_uid) DO UPDATE
+		SET starts_at = EXCLUDED.starts_at, ends_at = EXCLUDED.ends_at, summary = EXCLUDED.summary
+	`
+	for _, b := range blocks {
+		if _, err := tx.ExecContext(ctx, query, roomID, b.StartsAt, b.EndsAt, source, b.ExternalUID, b.Summary); err != nil {
+			return fmt.Errorf("failed to insert room block: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to replace room blocks: %w", err)
+	}
+	return nil
+}
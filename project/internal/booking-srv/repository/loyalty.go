@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/exceptions"
+)
+
+const (
+	LedgerEarn    = "earn"
+	LedgerRedeem  = "redeem"
+	LedgerReverse = "reverse"
+)
+
+func (r *Repository) GetLoyaltyBalance(ctx context.Context, userID int) (int, error) {
+	query := `SELECT COALESCE(SUM(points), 0) FROM loyalty_ledger WHERE user_id = $1`
+
+	var balance int
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&balance); err != nil {
+		return 0, fmt.Errorf("failed to get loyalty balance: %w", err)
+	}
+
+	return balance, nil
+}
+
+// GetStayNights counts nights of completed stays that ended within [from, to).
+func (r *Repository) GetStayNights(ctx context.Context, userID int, from, to time.Time) (int, error) {
+	query := `
+		SELECT COALESCE(SUM(check_out_date::date - check_in_date::date), 0)
+		FROM bookings
+		WHERE user_id = $1 AND status = 'checked_out'
+		AND check_out_date >= $2 AND check_out_date < $3
+	`
+
+	var nights int
+	if err := r.db.QueryRowContext(ctx, query, userID, from, to).Scan(&nights); err != nil {
+		return 0, fmt.Errorf("failed to count stay nights: %w", err)
+	}
+
+	return nights, nil
+}
+
+func redeemLoyaltyPoints(ctx context.Context, tx *sql.Tx, userID, bookingID, points int) error {
+	// Serialises balance checks per user so that two bookings cannot spend
+	// the same points.
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('loyalty_ledger'), $1)`, userID); err != nil {
+		return fmt.Errorf("failed to lock loyalty balance: %w", err)
+	}
+
+	var balance int
+	err := tx.QueryRowContext(ctx,
+		`SELECT COALESCE(SUM(points), 0) FROM loyalty_ledger WHERE user_id = $1`, userID,
+	).Scan(&balance)
+	if err != nil {
+		return fmt.Errorf("failed to get loyalty balance: %w", err)
+	}
+	if balance < points {
+		return fmt.Errorf("%w: loyalty balance is %d points", exceptions.ErrInsufficientFunds, balance)
+	}
+
+	return appendLedgerEntry(ctx, tx, userID, bookingID, LedgerRedeem, -points)
+}
+
+func appendLedgerEntry(ctx context.Context, tx *sql.Tx, userID, bookingID int, entryType string, points int) error {
+	query := `
+		INSERT INTO loyalty_ledger (user_id, booking_id, entry_type, points)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (booking_id, entry_type) DO NOTHING
+	`
+	if _, err := tx.ExecContext(ctx, query, userID, bookingID, entryType, points); err != nil {
+		return fmt.Errorf("failed to append loyalty entry: %w", err)
+	}
+	return nil
+}
+
+// reverseLedgerEntries cancels out everything the booking has earned or
+// spent so far.
+func reverseLedgerEntries(ctx context.Context, tx *sql.Tx, bookingID int) error {
+	query := `
+		INSERT INTO loyalty_ledger (user_id, booking_id, entry_type, points)
+		SELECT user_id, booking_id, 'reverse', -SUM(points)
+		FROM loyalty_ledger
+		WHERE booking_id = $1
+		GROUP BY user_id, booking_id
+		HAVING SUM(points) <> 0
+		ON CONFLICT (booking_id, entry_type) DO NOTHING
+	`
+	if _, err := tx.ExecContext(ctx, query, bookingID); err != nil {
+		return fmt.Errorf("failed to reverse loyalty entries: %w", err)
+	}
+	return nil
+}
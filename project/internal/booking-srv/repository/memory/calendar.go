@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"hotel-booking-system/internal/booking-srv/repository"
+)
+
+func (r *Repository) SaveCalendarToken(ctx context.Context, userID int, token string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens[userID] = token
+	return nil
+}
+
+func (r *Repository) GetUserIDByCalendarToken(ctx context.Context, token string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for userID, t := range r.tokens {
+		if t == token {
+			return userID, nil
+		}
+	}
+	return 0, repository.ErrNotFound
+}
+
+func (r *Repository) GetRoomBookings(ctx context.Context, roomID int) ([]repository.Booking, error) {
+	return r.listBookings(func(b *booking) bool { return b.RoomID == roomID && active(b.Status) }, ascByCheckIn), nil
+}
+
+func (r *Repository) GetRoomBlocks(ctx context.Context, roomID int) ([]repository.RoomBlock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var blocks []repository.RoomBlock
+	for _, b := range r.blocks {
+		if b.RoomID == roomID {
+			blocks = append(blocks, b)
+		}
+	}
+	slices.SortStableFunc(blocks, func(a, b repository.RoomBlock) int { return a.StartsAt.Compare(b.StartsAt) })
+	return blocks, nil
+}
+
+// ReplaceRoomBlocks keeps the IDs of blocks whose external UID is still in
+// the feed, like the upsert of the Postgres repository.
+func (r *Repository) ReplaceRoomBlocks(ctx context.Context, roomID int, source string, blocks []repository.RoomBlock) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing := make(map[string]int)
+	kept := r.blocks[:0:0]
+	for _, b := range r.blocks {
+		if b.RoomID == roomID && b.Source == source {
+			existing[b.ExternalUID] = b.ID
+			continue
+		}
+		kept = append(kept, b)
+	}
+
+	added := make(map[string]int)
+	for _, b := range blocks {
+		b.RoomID = roomID
+		b.Source = source
+		if i, ok := added[b.ExternalUID]; ok {
+			b.ID = kept[i].ID
+			kept[i] = b
+			continue
+		}
+		if id, ok := existing[b.ExternalUID]; ok {
+			b.ID = id
+		} else {
+			r.lastBlockID++
+			b.ID = r.lastBlockID
+		}
+		added[b.ExternalUID] = len(kept)
+		kept = append(kept, b)
+	}
+	r.blocks = kept
+	return nil
+}
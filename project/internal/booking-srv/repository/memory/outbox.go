@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/repository"
+)
+
+type outboxEntry struct {
+	msg           repository.OutboxMessage
+	nextAttemptAt time.Time
+	lastError     string
+	sent          bool
+}
+
+func (r *Repository) enqueueOutbox(msg repository.OutboxMessage) {
+	r.lastOutboxID++
+	msg.ID = r.lastOutboxID
+	msg.Attempts = 0
+	r.outbox = append(r.outbox, &outboxEntry{msg: msg, nextAttemptAt: r.now()})
+}
+
+// PendingOutbox returns the messages that have not been sent yet, oldest
+// first.
+func (r *Repository) PendingOutbox() []repository.OutboxMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var pending []repository.OutboxMessage
+	for _, e := range r.outbox {
+		if !e.sent {
+			pending = append(pending, e.msg)
+		}
+	}
+	return pending
+}
+
+// ProcessOutbox publishes without holding the data lock, so publish may call
+// back into the repository. Like the SQL version it keeps the order of
+// messages with the same key: one waiting for a retry holds back the later
+// ones, and the first failure stops the batch.
+func (r *Repository) ProcessOutbox(ctx context.Context, limit int, publish func(context.Context, repository.OutboxMessage) error) (int, error) {
+	r.relayMu.Lock()
+	defer r.relayMu.Unlock()
+
+	r.mu.Lock()
+	now := r.now()
+	var batch []*outboxEntry
+	blocked := map[string]bool{}
+	for _, e := range r.outbox {
+		if len(batch) == limit {
+			break
+		}
+		switch {
+		case e.sent:
+		case e.nextAttemptAt.After(now):
+			if e.msg.Key != "" {
+				blocked[e.msg.Key] = true
+			}
+		case !blocked[e.msg.Key]:
+			batch = append(batch, e)
+		}
+	}
+	r.mu.Unlock()
+
+	sent := 0
+	for _, e := range batch {
+		r.mu.Lock()
+		msg := e.msg
+		r.mu.Unlock()
+
+		publishErr := publish(ctx, msg)
+
+		r.mu.Lock()
+		if publishErr != nil {
+			backoff := min(time.Duration(1<<min(e.msg.Attempts, 9))*time.Second, 300*time.Second)
+			e.msg.Attempts++
+			e.lastError = publishErr.Error()
+			e.nextAttemptAt = r.now().Add(backoff)
+			r.mu.Unlock()
+			return sent, nil
+		}
+		e.sent = true
+		sent++
+		r.mu.Unlock()
+	}
+	return sent, nil
+}
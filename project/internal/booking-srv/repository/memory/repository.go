@@ -0,0 +1,517 @@
+// Package memory implements the booking-srv repository in memory. It follows
+// the semantics of the Postgres repository, including the booking history,
+// the outbox and the loyalty ledger, so that storage can be tested without a
+// database.
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/exceptions"
+	"hotel-booking-system/internal/booking-srv/repository"
+	"hotel-booking-system/internal/package/requestctx"
+)
+
+// Policy is the no-show policy of a hotel. Hotels without one use a 23:59
+// cutoff and a penalty of one night.
+type Policy struct {
+	NoShowCutoff  time.Duration
+	PenaltyNights int
+}
+
+var defaultPolicy = Policy{NoShowCutoff: 23*time.Hour + 59*time.Minute, PenaltyNights: 1}
+
+type booking struct {
+	repository.Booking
+	sagaID  int64
+	penalty float64
+}
+
+type ledgerEntry struct {
+	userID    int
+	bookingID int
+	entryType string
+	points    int
+}
+
+// Repository is safe for concurrent use. Every method works on a consistent
+// state, as if it ran in its own transaction.
+type Repository struct {
+	// relayMu keeps concurrent ProcessOutbox calls from sending the same
+	// messages, like SKIP LOCKED does in Postgres.
+	relayMu sync.Mutex
+
+	mu       sync.Mutex
+	now      func() time.Time
+	users    map[int]repository.User
+	policies map[int]Policy
+	bookings map[int]*booking
+	events   []repository.BookingEvent
+	ledger   []ledgerEntry
+	outbox   []*outboxEntry
+	sagas    map[int64]*sagaEntry
+	tokens   map[int]string
+	blocks   []repository.RoomBlock
+
+	lastBookingID, lastBlockID int
+	lastEventID, lastOutboxID  int64
+	lastSagaID                 int64
+}
+
+func New() *Repository {
+	return &Repository{
+		now:      time.Now,
+		users:    make(map[int]repository.User),
+		policies: make(map[int]Policy),
+		bookings: make(map[int]*booking),
+		sagas:    make(map[int64]*sagaEntry),
+		tokens:   make(map[int]string),
+	}
+}
+
+// SetClock replaces the clock used for timestamps, outbox retries and saga
+// progress.
+func (r *Repository) SetClock(now func() time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.now = now
+}
+
+// AddUser stores or replaces a user.
+func (r *Repository) AddUser(u repository.User) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.users[u.ID] = u
+}
+
+// SetPolicy sets the no-show policy of a hotel.
+func (r *Repository) SetPolicy(hotelID int, p Policy) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.policies[hotelID] = p
+}
+
+// AddBooking stores a booking as is, without history or outbox messages, and
+// returns its ID.
+func (r *Repository) AddBooking(b repository.Booking) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastBookingID++
+	b.ID = r.lastBookingID
+	r.bookings[b.ID] = &booking{Booking: b, sagaID: b.SagaID}
+	return b.ID
+}
+
+// AddLedgerEntry appends a loyalty ledger entry for a booking.
+func (r *Repository) AddLedgerEntry(userID, bookingID int, entryType string, points int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.appendLedgerEntry(userID, bookingID, entryType, points)
+}
+
+// Penalty returns the no-show penalty charged for a booking.
+func (r *Repository) Penalty(bookingID int) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if b, ok := r.bookings[bookingID]; ok {
+		return b.penalty
+	}
+	return 0
+}
+
+func (r *Repository) GetUser(ctx context.Context, userID int) (*repository.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[userID]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &u, nil
+}
+
+func (r *Repository) CreateBooking(ctx context.Context, b *repository.Booking, redeemPoints int, newEvent repository.ChangeEvent) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.occupied(b.RoomID, 0, b.CheckInDate, b.CheckOutDate) {
+		return 0, repository.ErrRoomNotAvailable
+	}
+	if redeemPoints > 0 {
+		if balance := r.balance(b.UserID); balance < redeemPoints {
+			return 0, fmt.Errorf("%w: loyalty balance is %d points", exceptions.ErrInsufficientFunds, balance)
+		}
+	}
+
+	created := *b
+	created.ID = r.lastBookingID + 1
+	created.SagaID = 0
+	var msg *repository.OutboxMessage
+	if newEvent != nil {
+		m, err := newEvent(nil, &created)
+		if err != nil {
+			return 0, err
+		}
+		msg = &m
+	}
+	if err := r.recordBookingEvent(ctx, created.ID, repository.ActionCreated, nil, &created); err != nil {
+		return 0, err
+	}
+
+	r.lastBookingID = created.ID
+	r.bookings[created.ID] = &booking{Booking: created, sagaID: b.SagaID}
+	if redeemPoints > 0 {
+		r.appendLedgerEntry(b.UserID, created.ID, repository.LedgerRedeem, -redeemPoints)
+	}
+	if msg != nil {
+		r.enqueueOutbox(*msg)
+	}
+	return created.ID, nil
+}
+
+func (r *Repository) GetBooking(ctx context.Context, bookingID int) (*repository.Booking, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, ok := r.bookings[bookingID]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	result := b.Booking
+	result.SagaID = 0
+	return &result, nil
+}
+
+func (r *Repository) UpdateStatus(ctx context.Context, bookingID int, from, to string, newEvent repository.ChangeEvent) (bool, error) {
+	return r.mutateBooking(ctx, bookingID, to, func(b *booking) (bool, error) {
+		if b.Status != from {
+			return false, nil
+		}
+		b.Status = to
+		return true, nil
+	}, newEvent)
+}
+
+func (r *Repository) CheckOut(ctx context.Context, bookingID, userID, points int, newEvent repository.ChangeEvent) (bool, error) {
+	return r.mutateBooking(ctx, bookingID, repository.ActionCheckedOut, func(b *booking) (bool, error) {
+		if b.Status != repository.StatusCheckedIn {
+			return false, nil
+		}
+		b.Status = repository.StatusCheckedOut
+		if points > 0 {
+			r.appendLedgerEntry(userID, bookingID, repository.LedgerEarn, points)
+		}
+		return true, nil
+	}, newEvent)
+}
+
+func (r *Repository) CancelBooking(ctx context.Context, bookingID int, newEvent repository.ChangeEvent) (bool, error) {
+	return r.mutateBooking(ctx, bookingID, repository.ActionCancelled, func(b *booking) (bool, error) {
+		if b.Status == repository.StatusCancelled || b.Status == repository.StatusNoShow {
+			return false, nil
+		}
+		b.Status = repository.StatusCancelled
+		r.reverseLedgerEntries(bookingID)
+		return true, nil
+	}, newEvent)
+}
+
+func (r *Repository) ReleaseHold(ctx context.Context, bookingID int) (bool, error) {
+	return r.mutateBooking(ctx, bookingID, repository.ActionHoldReleased, func(b *booking) (bool, error) {
+		if b.Status != repository.StatusPending {
+			return false, nil
+		}
+		b.Status = repository.StatusCancelled
+		r.reverseLedgerEntries(bookingID)
+		return true, nil
+	}, nil)
+}
+
+func (r *Repository) ModifyBooking(ctx context.Context, changed *repository.Booking, newEvent repository.ChangeEvent) (bool, error) {
+	return r.mutateBooking(ctx, changed.ID, repository.ActionModified, func(b *booking) (bool, error) {
+		if b.Status != repository.StatusConfirmed {
+			return false, nil
+		}
+		if r.occupied(b.RoomID, b.ID, changed.CheckInDate, changed.CheckOutDate) {
+			return false, repository.ErrRoomNotAvailable
+		}
+		b.CheckInDate = changed.CheckInDate
+		b.CheckOutDate = changed.CheckOutDate
+		b.GuestsCount = changed.GuestsCount
+		b.TotalPrice = changed.TotalPrice
+		return true, nil
+	}, newEvent)
+}
+
+func (r *Repository) GetUserBookings(ctx context.Context, userID int) ([]repository.Booking, error) {
+	return r.listBookings(func(b *booking) bool { return b.UserID == userID }, descByCheckIn), nil
+}
+
+func (r *Repository) GetHotelBookings(ctx context.Context, hotelID int) ([]repository.Booking, error) {
+	return r.listBookings(func(b *booking) bool { return b.HotelID == hotelID }, descByCheckIn), nil
+}
+
+func (r *Repository) GetBusyRooms(ctx context.Context, checkIn, checkOut time.Time) (map[int]bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	busy := make(map[int]bool)
+	for _, b := range r.bookings {
+		if active(b.Status) && overlaps(b.CheckInDate, b.CheckOutDate, checkIn, checkOut) {
+			busy[b.RoomID] = true
+		}
+	}
+	for _, b := range r.blocks {
+		if overlaps(b.StartsAt, b.EndsAt, checkIn, checkOut) {
+			busy[b.RoomID] = true
+		}
+	}
+	return busy, nil
+}
+
+func (r *Repository) GetNoShowCandidates(ctx context.Context, now time.Time) ([]repository.NoShowCandidate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var candidates []repository.NoShowCandidate
+	for _, b := range r.sortedBookings(nil) {
+		if b.Status != repository.StatusConfirmed {
+			continue
+		}
+		policy, ok := r.policies[b.HotelID]
+		if !ok {
+			policy = defaultPolicy
+		}
+		y, m, d := b.CheckInDate.Date()
+		cutoff := time.Date(y, m, d, 0, 0, 0, 0, b.CheckInDate.Location()).Add(policy.NoShowCutoff)
+		if cutoff.Before(now) {
+			candidates = append(candidates, repository.NoShowCandidate{Booking: b.Booking, PenaltyNights: policy.PenaltyNights})
+		}
+	}
+	return candidates, nil
+}
+
+func (r *Repository) MarkNoShow(ctx context.Context, bookingID int, penalty float64, newEvent repository.ChangeEvent) (bool, error) {
+	return r.mutateBooking(ctx, bookingID, repository.ActionNoShow, func(b *booking) (bool, error) {
+		if b.Status != repository.StatusConfirmed {
+			return false, nil
+		}
+		b.Status = repository.StatusNoShow
+		b.penalty = penalty
+		return true, nil
+	}, newEvent)
+}
+
+func (r *Repository) GetBookingEvents(ctx context.Context, bookingID int) ([]repository.BookingEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var history []repository.BookingEvent
+	for _, e := range r.events {
+		if e.BookingID == bookingID {
+			history = append(history, e)
+		}
+	}
+	return history, nil
+}
+
+func (r *Repository) GetLoyaltyBalance(ctx context.Context, userID int) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.balance(userID), nil
+}
+
+func (r *Repository) GetStayNights(ctx context.Context, userID int, from, to time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	nights := 0
+	for _, b := range r.bookings {
+		if b.UserID != userID || b.Status != repository.StatusCheckedOut {
+			continue
+		}
+		if b.CheckOutDate.Before(from) || !b.CheckOutDate.Before(to) {
+			continue
+		}
+		nights += int(date(b.CheckOutDate).Sub(date(b.CheckInDate)).Hours() / 24)
+	}
+	return nights, nil
+}
+
+// mutateBooking changes a copy of the booking with apply and stores it
+// together with the history entry and the outbox message only if everything
+// succeeds.
+func (r *Repository) mutateBooking(ctx context.Context, bookingID int, action string, apply func(b *booking) (bool, error), newEvent repository.ChangeEvent) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.bookings[bookingID]
+	if !ok {
+		return false, repository.ErrNotFound
+	}
+	before := stored.Booking
+	before.SagaID = 0
+
+	// The ledger is only appended to, so rolling it back means truncating.
+	ledgerLen := len(r.ledger)
+	changed := *stored
+	ok, err := apply(&changed)
+	if err != nil || !ok {
+		r.ledger = r.ledger[:ledgerLen]
+		return false, err
+	}
+	after := changed.Booking
+	after.SagaID = 0
+
+	var msg *repository.OutboxMessage
+	if newEvent != nil {
+		m, err := newEvent(&before, &after)
+		if err != nil {
+			r.ledger = r.ledger[:ledgerLen]
+			return false, err
+		}
+		msg = &m
+	}
+	if err := r.recordBookingEvent(ctx, bookingID, action, &before, &after); err != nil {
+		r.ledger = r.ledger[:ledgerLen]
+		return false, err
+	}
+
+	*stored = changed
+	if msg != nil {
+		r.enqueueOutbox(*msg)
+	}
+	return true, nil
+}
+
+func (r *Repository) recordBookingEvent(ctx context.Context, bookingID int, action string, before, after *repository.Booking) error {
+	beforeJSON, err := snapshot(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := snapshot(after)
+	if err != nil {
+		return err
+	}
+
+	r.lastEventID++
+	r.events = append(r.events, repository.BookingEvent{
+		ID:        r.lastEventID,
+		BookingID: bookingID,
+		Action:    action,
+		Actor:     requestctx.Actor(ctx),
+		RequestID: requestctx.RequestID(ctx),
+		Before:    beforeJSON,
+		After:     afterJSON,
+		CreatedAt: r.now(),
+	})
+	return nil
+}
+
+func snapshot(b *repository.Booking) (json.RawMessage, error) {
+	if b == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal booking snapshot: %w", err)
+	}
+	return data, nil
+}
+
+func (r *Repository) balance(userID int) int {
+	balance := 0
+	for _, e := range r.ledger {
+		if e.userID == userID {
+			balance += e.points
+		}
+	}
+	return balance
+}
+
+// appendLedgerEntry keeps one entry per booking and type, like the unique
+// constraint on loyalty_ledger.
+func (r *Repository) appendLedgerEntry(userID, bookingID int, entryType string, points int) {
+	for _, e := range r.ledger {
+		if e.bookingID == bookingID && e.entryType == entryType {
+			return
+		}
+	}
+	r.ledger = append(r.ledger, ledgerEntry{userID: userID, bookingID: bookingID, entryType: entryType, points: points})
+}
+
+func (r *Repository) reverseLedgerEntries(bookingID int) {
+	userID, sum, found := 0, 0, false
+	for _, e := range r.ledger {
+		if e.bookingID == bookingID {
+			userID, sum, found = e.userID, sum+e.points, true
+		}
+	}
+	if found && sum != 0 {
+		r.appendLedgerEntry(userID, bookingID, repository.LedgerReverse, -sum)
+	}
+}
+
+// occupied reports whether another booking or a block takes the room at any
+// time within [checkIn, checkOut).
+func (r *Repository) occupied(roomID, exceptID int, checkIn, checkOut time.Time) bool {
+	for _, b := range r.bookings {
+		if b.RoomID == roomID && b.ID != exceptID && active(b.Status) && overlaps(b.CheckInDate, b.CheckOutDate, checkIn, checkOut) {
+			return true
+		}
+	}
+	for _, b := range r.blocks {
+		if b.RoomID == roomID && overlaps(b.StartsAt, b.EndsAt, checkIn, checkOut) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Repository) listBookings(match func(*booking) bool, cmp func(a, b *booking) int) []repository.Booking {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sorted := r.sortedBookings(match)
+	slices.SortStableFunc(sorted, cmp)
+	var bookings []repository.Booking
+	for _, b := range sorted {
+		result := b.Booking
+		result.SagaID = 0
+		bookings = append(bookings, result)
+	}
+	return bookings
+}
+
+// sortedBookings returns the matching bookings ordered by ID. A nil match
+// returns all of them.
+func (r *Repository) sortedBookings(match func(*booking) bool) []*booking {
+	var result []*booking
+	for _, b := range r.bookings {
+		if match == nil || match(b) {
+			result = append(result, b)
+		}
+	}
+	slices.SortFunc(result, func(a, b *booking) int { return a.ID - b.ID })
+	return result
+}
+
+func descByCheckIn(a, b *booking) int { return b.CheckInDate.Compare(a.CheckInDate) }
+
+func ascByCheckIn(a, b *booking) int { return a.CheckInDate.Compare(b.CheckInDate) }
+
+func active(status string) bool {
+	return status != repository.StatusCancelled && status != repository.StatusNoShow
+}
+
+func overlaps(start, end, from, to time.Time) bool {
+	return end.After(from) && start.Before(to)
+}
+
+func date(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/repository"
+)
+
+type sagaEntry struct {
+	rec       repository.SagaRecord
+	updatedAt time.Time
+}
+
+func (r *Repository) CreateSaga(ctx context.Context, rec *repository.SagaRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastSagaID++
+	rec.ID = r.lastSagaID
+	r.sagas[rec.ID] = &sagaEntry{rec: cloneSaga(*rec), updatedAt: r.now()}
+	return nil
+}
+
+func (r *Repository) SaveSaga(ctx context.Context, rec *repository.SagaRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.sagas[rec.ID]
+	if !ok {
+		return fmt.Errorf("failed to save saga %d: %w", rec.ID, repository.ErrNotFound)
+	}
+	e.rec.Status = rec.Status
+	e.rec.Step = rec.Step
+	e.rec.Data = slices.Clone(rec.Data)
+	e.rec.LastError = rec.LastError
+	e.updatedAt = r.now()
+	return nil
+}
+
+func (r *Repository) ClaimStaleSagas(ctx context.Context, sagaType string, staleBefore time.Time, limit int) ([]repository.SagaRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make([]int64, 0, len(r.sagas))
+	for id := range r.sagas {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	var sagas []repository.SagaRecord
+	for _, id := range ids {
+		if len(sagas) == limit {
+			break
+		}
+		e := r.sagas[id]
+		inFlight := e.rec.Status == repository.SagaRunning || e.rec.Status == repository.SagaCompensating
+		if e.rec.Type != sagaType || !inFlight || !e.updatedAt.Before(staleBefore) {
+			continue
+		}
+		e.updatedAt = r.now()
+		sagas = append(sagas, cloneSaga(e.rec))
+	}
+	return sagas, nil
+}
+
+func (r *Repository) GetBookingIDBySaga(ctx context.Context, sagaID int64) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, b := range r.bookings {
+		if b.sagaID != 0 && b.sagaID == sagaID {
+			return b.ID, nil
+		}
+	}
+	return 0, repository.ErrNotFound
+}
+
+// Sagas returns every saga, oldest first.
+func (r *Repository) Sagas() []repository.SagaRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var sagas []repository.SagaRecord
+	for _, e := range r.sagas {
+		sagas = append(sagas, cloneSaga(e.rec))
+	}
+	slices.SortFunc(sagas, func(a, b repository.SagaRecord) int { return int(a.ID - b.ID) })
+	return sagas
+}
+
+func cloneSaga(rec repository.SagaRecord) repository.SagaRecord {
+	rec.Data = slices.Clone(rec.Data)
+	return rec
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type OutboxMessage struct {
+	ID       int64
+	Topic    string
+	Key      string
+	Payload  []byte
+	Attempts int
+}
+
+// ChangeEvent builds the outbox message for a booking change from the
+// snapshots taken inside the transaction. before is nil for a new booking.
+type ChangeEvent func(before, after *Booking) (OutboxMessage, error)
+
+func enqueueOutbox(ctx context.Context, tx *sql.Tx, msg OutboxMessage) error {
+	query := `INSERT INTO outbox (topic, message_key, payload) VALUES ($1, $2, $3)`
+	if _, err := tx.ExecContext(ctx, query, msg.Topic, msg.Key, string(msg.Payload)); err != nil {
+		return fmt.Errorf("failed to enqueue outbox message: %w", err)
+	}
+	return nil
+}
+
+// outboxLease is how long claimed messages stay hidden from other relays.
+// Publishing a batch is cut off at half of it, so a batch that is still
+// being published is never claimed twice.
+const outboxLease = time.Minute
+
+// ProcessOutbox hands up to limit pending messages to publish and marks the
+// published ones as sent. The messages are claimed in a short transaction
+// and published after it commits, so no row lock is held while Kafka is
+// slow.
+//
+// Messages with the same key are published in ID order: a message is not
+// claimed while an earlier one with its key is waiting for a retry or is
+// claimed by another relay, and the first failure stops the batch. Failed
+// messages are retried with exponential backoff capped at five minutes.
+func (r *Repository) ProcessOutbox(ctx context.Context, limit int, publish func(context.Context, OutboxMessage) error) (int, error) {
+	batch, err := r.claimOutbox(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, outboxLease/2)
+	defer cancel()
+
+	sent := 0
+	for i, m := range batch {
+		if publishErr := publish(publishCtx, m); publishErr != nil {
+			_, err := r.db.ExecContext(ctx, `
+				UPDATE outbox
+				SET attempts = attempts + 1,
+				    last_error = $2,
+				    next_attempt_at = NOW() + LEAST(POWER(2, attempts), 300) * INTERVAL '1 second'
+				WHERE id = $1
+			`, m.ID, publishErr.Error())
+			if err != nil {
+				return sent, fmt.Errorf("failed to reschedule outbox message: %w", err)
+			}
+			return sent, r.releaseOutbox(ctx, batch[i+1:])
+		}
+
+		if _, err := r.db.ExecContext(ctx, `UPDATE outbox SET sent_at = NOW() WHERE id = $1`, m.ID); err != nil {
+			return sent, fmt.Errorf("failed to mark outbox message as sent: %w", err)
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// claimOutbox leases the next due messages to this relay. Claims are
+// serialized by an advisory lock: with SKIP LOCKED a relay could claim a
+// message whose predecessor another relay is claiming at the same moment.
+func (r *Repository) claimOutbox(ctx context.Context, limit int) ([]OutboxMessage, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('outbox_relay'))`); err != nil {
+		return nil, fmt.Errorf("failed to lock outbox: %w", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE outbox
+		SET next_attempt_at = NOW() + $2 * INTERVAL '1 second'
+		WHERE id IN (
+			SELECT o.id
+			FROM outbox o
+			WHERE o.sent_at IS NULL AND o.next_attempt_at <= NOW()
+			  AND NOT EXISTS (
+				SELECT 1 FROM outbox e
+				WHERE e.message_key = o.message_key AND e.message_key <> ''
+				  AND e.sent_at IS NULL AND e.id < o.id AND e.next_attempt_at > NOW()
+			  )
+			ORDER BY o.id
+			LIMIT $1
+		)
+		RETURNING id, topic, message_key, payload, attempts
+	`, limit, outboxLease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox messages: %w", err)
+	}
+
+	var batch []OutboxMessage
+	for rows.Next() {
+		var m OutboxMessage
+		var payload string
+		if err := rows.Scan(&m.ID, &m.Topic, &m.Key, &payload, &m.Attempts); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan outbox message: %w", err)
+		}
+		m.Payload = []byte(payload)
+		batch = append(batch, m)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read outbox: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	slices.SortFunc(batch, func(a, b OutboxMessage) int { return cmp.Compare(a.ID, b.ID) })
+	return batch, nil
+}
+
+// releaseOutbox makes claimed messages that were not published due again.
+// Those behind a failed message of the same key stay blocked by it.
+func (r *Repository) releaseOutbox(ctx context.Context, batch []OutboxMessage) error {
+	for _, m := range batch {
+		if _, err := r.db.ExecContext(ctx, `UPDATE outbox SET next_attempt_at = NOW() WHERE id = $1`, m.ID); err != nil {
+			return fmt.Errorf("failed to release outbox message: %w", err)
+		}
+	}
+	return nil
+}
@@ -5,17 +5,52 @@ import (
 	"database/sql"
 	"fmt"
 	"time"
+
+	"hotel-booking-system/internal/booking-srv/exceptions"
+)
+
+var (
+	ErrNotFound         = exceptions.ErrNotFound
+	ErrRoomNotAvailable = exceptions.ErrRoomNotAvailable
+)
+
+const (
+	StatusPending    = "pending"
+	StatusConfirmed  = "confirmed"
+	StatusCheckedIn  = "checked_in"
+	StatusCheckedOut = "checked_out"
+	StatusCancelled  = "cancelled"
+	StatusNoShow     = "no_show"
 )
 
 type Booking struct {
-	ID           int       `json:"id"`
-	UserID       int       `json:"user_id"`
-	HotelID      int       `json:"hotel_id"`
-	RoomID       int       `json:"room_id"`
-	CheckInDate  time.Time `json:"check_in_date"`
-	CheckOutDate time.Time `json:"check_out_date"`
-	GuestsCount  int       `json:"guests_count"`
-	TotalPrice   float64   `json:"total_price"`
+	ID              int       `json:"id"`
+	UserID          int       `json:"user_id"`
+	HotelID         int       `json:"hotel_id"`
+	RoomID          int       `json:"room_id"`
+	CheckInDate     time.Time `json:"check_in_date"`
+	CheckOutDate    time.Time `json:"check_out_date"`
+	GuestsCount     int       `json:"guests_count"`
+	TotalPrice      float64   `json:"total_price"`
+	LoyaltyDiscount float64   `json:"loyalty_discount"`
+	Status          string    `json:"status"`
+	HotelName       string    `json:"hotel_name"`
+	HotelAddress    string    `json:"hotel_address"`
+	HotelPhone      string    `json:"hotel_phone"`
+	RoomTypeName    string    `json:"room_type_name"`
+	SagaID          int64     `json:"-"`
+}
+
+type User struct {
+	ID       int    `json:"id"`
+	Email    string `json:"email"`
+	FullName string `json:"full_name"`
+	Phone    string `json:"phone"`
+}
+
+type NoShowCandidate struct {
+	Booking
+	PenaltyNights int
 }
 
 type Repository struct {
@@ -26,15 +61,40 @@ func NewRepository(db *sql.DB) *Repository {
 	return &Repository{db: db}
 }
 
-func (r *Repository) CreateBooking(ctx context.Context, booking *Booking) (int, error) {
+// CreateBooking inserts the booking, queues the event built by newEvent in the
+// outbox and, when redeemPoints is positive, debits the user's loyalty
+// balance, all in the same transaction. It fails with ErrRoomNotAvailable
+// when the room is taken for the dates, checked under the room's lock so
+// that concurrent bookings cannot both get it. booking.SagaID, which is only
+// written here and never read back into Booking, links the row to the saga
+// that holds it.
+func (r *Repository) CreateBooking(ctx context.Context, booking *Booking, redeemPoints int, newEvent ChangeEvent) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create booking: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockRoom(ctx, tx, booking.RoomID); err != nil {
+		return 0, err
+	}
+	occupied, err := roomOccupied(ctx, tx, booking.RoomID, 0, booking.CheckInDate, booking.CheckOutDate)
+	if err != nil {
+		return 0, err
+	}
+	if occupied {
+		return 0, ErrRoomNotAvailable
+	}
+
 	query := `
 		INSERT INTO bookings 
-		(user_id, hotel_id, room_id, check_in_date, check_out_date, guests_count, total_price)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		(user_id, hotel_id, room_id, check_in_date, check_out_date, guests_count, total_price, loyalty_discount,
+		 hotel_name, hotel_address, hotel_phone, room_type_name, status, saga_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NULLIF($14, 0))
 		RETURNING id
 	`
 	var id int
-	err := r.db.QueryRowContext(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		booking.UserID,
 		booking.HotelID,
 		booking.RoomID,
@@ -42,19 +102,238 @@ func (r *Repository) CreateBooking(ctx context.Context, booking *Booking) (int,
 		booking.CheckOutDate,
 		booking.GuestsCount,
 		booking.TotalPrice,
+		booking.LoyaltyDiscount,
+		booking.HotelName,
+		booking.HotelAddress,
+		booking.HotelPhone,
+		booking.RoomTypeName,
+		booking.Status,
+		booking.SagaID,
 	).Scan(&id)
 
 	if err != nil {
 		return 0, fmt.Errorf("failed to create booking: %w", err)
 	}
 
+	if redeemPoints > 0 {
+		if err := redeemLoyaltyPoints(ctx, tx, booking.UserID, id, redeemPoints); err != nil {
+			return 0, err
+		}
+	}
+
+	created, err := getBookingForUpdate(ctx, tx, id)
+	if err != nil {
+		return 0, err
+	}
+	if err := recordBookingEvent(ctx, tx, id, ActionCreated, nil, created); err != nil {
+		return 0, err
+	}
+
+	if newEvent != nil {
+		msg, err := newEvent(nil, created)
+		if err != nil {
+			return 0, err
+		}
+		if err := enqueueOutbox(ctx, tx, msg); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to create booking: %w", err)
+	}
+
 	return id, nil
 }
 
+func (r *Repository) GetBooking(ctx context.Context, bookingID int) (*Booking, error) {
+	query := `
+		SELECT id, user_id, hotel_id, room_id, check_in_date, check_out_date,
+		       guests_count, total_price, loyalty_discount, status,
+		       hotel_name, hotel_address, hotel_phone, room_type_name
+		FROM bookings
+		WHERE id = $1
+	`
+
+	var b Booking
+	err := r.db.QueryRowContext(ctx, query, bookingID).Scan(
+		&b.ID,
+		&b.UserID,
+		&b.HotelID,
+		&b.RoomID,
+		&b.CheckInDate,
+		&b.CheckOutDate,
+		&b.GuestsCount,
+		&b.TotalPrice,
+		&b.LoyaltyDiscount,
+		&b.Status,
+		&b.HotelName,
+		&b.HotelAddress,
+		&b.HotelPhone,
+		&b.RoomTypeName,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking: %w", err)
+	}
+
+	return &b, nil
+}
+
+// UpdateStatus moves a booking from one status to another and reports false
+// when the booking is not in the expected status.
+func (r *Repository) UpdateStatus(ctx context.Context, bookingID int, from, to string, newEvent ChangeEvent) (bool, error) {
+	return r.mutateBooking(ctx, bookingID, to, func(tx *sql.Tx, before *Booking) (bool, error) {
+		if before.Status != from {
+			return false, nil
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE bookings SET status = $2 WHERE id = $1`, bookingID, to); err != nil {
+			return false, fmt.Errorf("failed to update booking status: %w", err)
+		}
+		return true, nil
+	}, newEvent)
+}
+
+// CheckOut completes the stay and credits the earned loyalty points in one
+// transaction. It reports false when the booking is not checked in.
+func (r *Repository) CheckOut(ctx context.Context, bookingID, userID, points int, newEvent ChangeEvent) (bool, error) {
+	return r.mutateBooking(ctx, bookingID, ActionCheckedOut, func(tx *sql.Tx, before *Booking) (bool, error) {
+		if before.Status != StatusCheckedIn {
+			return false, nil
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE bookings SET status = 'checked_out' WHERE id = $1`, bookingID); err != nil {
+			return false, fmt.Errorf("failed to check out booking: %w", err)
+		}
+		if points > 0 {
+			if err := appendLedgerEntry(ctx, tx, userID, bookingID, LedgerEarn, points); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}, newEvent)
+}
+
+// CancelBooking cancels the booking and reverses its loyalty entries in one
+// transaction. It reports false when the booking is already cancelled or
+// marked as no-show.
+func (r *Repository) CancelBooking(ctx context.Context, bookingID int, newEvent ChangeEvent) (bool, error) {
+	return r.mutateBooking(ctx, bookingID, ActionCancelled, func(tx *sql.Tx, before *Booking) (bool, error) {
+		if before.Status == StatusCancelled || before.Status == StatusNoShow {
+			return false, nil
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE bookings SET status = 'cancelled' WHERE id = $1`, bookingID); err != nil {
+			return false, fmt.Errorf("failed to cancel booking: %w", err)
+		}
+		if err := reverseLedgerEntries(ctx, tx, bookingID); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, newEvent)
+}
+
+// ReleaseHold cancels a booking that was held but never confirmed and returns
+// the loyalty points redeemed for it. It reports false when the booking is
+// not pending.
+func (r *Repository) ReleaseHold(ctx context.Context, bookingID int) (bool, error) {
+	return r.mutateBooking(ctx, bookingID, ActionHoldReleased, func(tx *sql.Tx, before *Booking) (bool, error) {
+		if before.Status != StatusPending {
+			return false, nil
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE bookings SET status = 'cancelled' WHERE id = $1`, bookingID); err != nil {
+			return false, fmt.Errorf("failed to release booking hold: %w", err)
+		}
+		if err := reverseLedgerEntries(ctx, tx, bookingID); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, nil)
+}
+
+// ModifyBooking moves the booking to new dates and guest count in the same
+// room. It fails with ErrRoomNotAvailable when the new dates overlap another
+// booking or an imported block, and reports false when the booking is no
+// longer confirmed.
+func (r *Repository) ModifyBooking(ctx context.Context, changed *Booking, newEvent ChangeEvent) (bool, error) {
+	return r.mutateBooking(ctx, changed.ID, ActionModified, func(tx *sql.Tx, before *Booking) (bool, error) {
+		if before.Status != StatusConfirmed {
+			return false, nil
+		}
+
+		if err := lockRoom(ctx, tx, before.RoomID); err != nil {
+			return false, err
+		}
+		occupied, err := roomOccupied(ctx, tx, before.RoomID, before.ID, changed.CheckInDate, changed.CheckOutDate)
+		if err != nil {
+			return false, err
+		}
+		if occupied {
+			return false, ErrRoomNotAvailable
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE bookings
+			SET check_in_date = $2, check_out_date = $3, guests_count = $4, total_price = $5
+			WHERE id = $1
+		`, before.ID, changed.CheckInDate, changed.CheckOutDate, changed.GuestsCount, changed.TotalPrice)
+		if err != nil {
+			return false, fmt.Errorf("failed to modify booking: %w", err)
+		}
+		return true, nil
+	}, newEvent)
+}
+
+// lockRoom serializes the transactions that check a room's availability and
+// then take it, until tx ends.
+func lockRoom(ctx context.Context, tx *sql.Tx, roomID int) error {
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('room_availability'), $1)`, roomID); err != nil {
+		return fmt.Errorf("failed to lock room %d: %w", roomID, err)
+	}
+	return nil
+}
+
+// roomOccupied reports whether an active booking other than exceptID or an
+// imported block overlaps the dates. Callers hold the room's lock.
+func roomOccupied(ctx context.Context, tx *sql.Tx, roomID, exceptID int, checkIn, checkOut time.Time) (bool, error) {
+	var occupied bool
+	err := tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM bookings
+			WHERE room_id = $1 AND id <> $2
+			AND status NOT IN ('cancelled', 'no_show')
+			AND NOT (check_out_date <= $3 OR check_in_date >= $4)
+		) OR EXISTS (
+			SELECT 1 FROM room_blocks
+			WHERE room_id = $1
+			AND NOT (ends_at <= $3 OR starts_at >= $4)
+		)
+	`, roomID, exceptID, checkIn, checkOut).Scan(&occupied)
+	if err != nil {
+		return false, fmt.Errorf("failed to check availability: %w", err)
+	}
+	return occupied, nil
+}
+
+func (r *Repository) GetUser(ctx context.Context, userID int) (*User, error) {
+	var u User
+	err := r.db.QueryRowContext(ctx,
+		`SELECT id, email, full_name, phone FROM users WHERE id = $1`, userID,
+	).Scan(&u.ID, &u.Email, &u.FullName, &u.Phone)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return &u, nil
+}
+
 func (r *Repository) GetUserBookings(ctx context.Context, userID int) ([]Booking, error) {
 	query := `
 		SELECT id, user_id, hotel_id, room_id, check_in_date, check_out_date, 
-		       guests_count, total_price
+		       guests_count, total_price, loyalty_discount, status,
+		       hotel_name, hotel_address, hotel_phone, room_type_name
 		FROM bookings 
 		WHERE user_id = $1
 		ORDER BY check_in_date DESC
@@ -78,6 +357,12 @@ func (r *Repository) GetUserBookings(ctx context.Context, userID int) ([]Booking
 			&b.CheckOutDate,
 			&b.GuestsCount,
 			&b.TotalPrice,
+			&b.LoyaltyDiscount,
+			&b.Status,
+			&b.HotelName,
+			&b.HotelAddress,
+			&b.HotelPhone,
+			&b.RoomTypeName,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan booking: %w", err)
@@ -93,7 +378,12 @@ func (r *Repository) CheckRoomAvailability(ctx context.Context, roomID int, chec
 		SELECT EXISTS (
 			SELECT 1 FROM bookings 
 			WHERE room_id = $1 
+			AND status NOT IN ('cancelled', 'no_show')
 			AND NOT (check_out_date <= $2 OR check_in_date >= $3)
+		) OR EXISTS (
+			SELECT 1 FROM room_blocks
+			WHERE room_id = $1
+			AND NOT (ends_at <= $2 OR starts_at >= $3)
 		)
 	`
 
@@ -110,7 +400,12 @@ func (r *Repository) GetBusyRooms(ctx context.Context, checkIn, checkOut time.Ti
 	query := `
         SELECT room_id 
         FROM bookings 
-        WHERE NOT (check_out_date <= $1 OR check_in_date >= $2)
+        WHERE status NOT IN ('cancelled', 'no_show')
+        AND NOT (check_out_date <= $1 OR check_in_date >= $2)
+        UNION
+        SELECT room_id
+        FROM room_blocks
+        WHERE NOT (ends_at <= $1 OR starts_at >= $2)
     `
 	rows, err := r.db.QueryContext(ctx, query, checkIn, checkOut)
 	if err != nil {
@@ -132,7 +427,8 @@ func (r *Repository) GetBusyRooms(ctx context.Context, checkIn, checkOut time.Ti
 func (r *Repository) GetHotelBookings(ctx context.Context, hotelID int) ([]Booking, error) {
 	query := `
 		SELECT id, user_id, hotel_id, room_id, check_in_date, check_out_date, 
-		       guests_count, total_price
+		       guests_count, total_price, loyalty_discount, status,
+		       hotel_name, hotel_address, hotel_phone, room_type_name
 		FROM bookings 
 		WHERE hotel_id = $1
 		ORDER BY check_in_date DESC
@@ -156,6 +452,12 @@ func (r *Repository) GetHotelBookings(ctx context.Context, hotelID int) ([]Booki
 			&b.CheckOutDate,
 			&b.GuestsCount,
 			&b.TotalPrice,
+			&b.LoyaltyDiscount,
+			&b.Status,
+			&b.HotelName,
+			&b.HotelAddress,
+			&b.HotelPhone,
+			&b.RoomTypeName,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan booking: %w", err)
@@ -165,3 +467,66 @@ func (r *Repository) GetHotelBookings(ctx context.Context, hotelID int) ([]Booki
 
 	return bookings, nil
 }
+
+func (r *Repository) GetNoShowCandidates(ctx context.Context, now time.Time) ([]NoShowCandidate, error) {
+	query := `
+		SELECT b.id, b.user_id, b.hotel_id, b.room_id, b.check_in_date, b.check_out_date,
+		       b.guests_count, b.total_price, b.loyalty_discount, b.status,
+		       b.hotel_name, b.hotel_address, b.hotel_phone, b.room_type_name,
+		       COALESCE(p.no_show_penalty_nights, 1)
+		FROM bookings b
+		LEFT JOIN cancellation_policies p ON p.hotel_id = b.hotel_id
+		WHERE b.status = 'confirmed'
+		AND b.check_in_date::date + COALESCE(p.no_show_cutoff, TIME '23:59') < $1
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query no-show candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []NoShowCandidate
+	for rows.Next() {
+		var c NoShowCandidate
+		err := rows.Scan(
+			&c.ID,
+			&c.UserID,
+			&c.HotelID,
+			&c.RoomID,
+			&c.CheckInDate,
+			&c.CheckOutDate,
+			&c.GuestsCount,
+			&c.TotalPrice,
+			&c.LoyaltyDiscount,
+			&c.Status,
+			&c.HotelName,
+			&c.HotelAddress,
+			&c.HotelPhone,
+			&c.RoomTypeName,
+			&c.PenaltyNights,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan no-show candidate: %w", err)
+		}
+		candidates = append(candidates, c)
+	}
+
+	return candidates, nil
+}
+
+// MarkNoShow applies the penalty and queues the no-show event. It reports
+// false when the booking has already left the confirmed state, e.g. because
+// another replica processed it first.
+func (r *Repository) MarkNoShow(ctx context.Context, bookingID int, penalty float64, newEvent ChangeEvent) (bool, error) {
+	return r.mutateBooking(ctx, bookingID, ActionNoShow, func(tx *sql.Tx, before *Booking) (bool, error) {
+		if before.Status != StatusConfirmed {
+			return false, nil
+		}
+		query := `UPDATE bookings SET status = 'no_show', penalty_amount = $2 WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, query, bookingID, penalty); err != nil {
+			return false, fmt.Errorf("failed to mark booking as no-show: %w", err)
+		}
+		return true, nil
+	}, newEvent)
+}
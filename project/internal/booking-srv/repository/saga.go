@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+const (
+	SagaRunning      = "running"
+	SagaCompensating = "compensating"
+	SagaCompleted    = "completed"
+	SagaCompensated  = "compensated"
+)
+
+// SagaRecord is the persisted state of a saga. Step is the number of steps
+// that have taken effect: the next one to run while the saga is running, and
+// the next one to undo (minus one) while it is compensating.
+type SagaRecord struct {
+	ID        int64
+	Type      string
+	Status    string
+	Step      int
+	Data      []byte
+	LastError string
+	Actor     string
+	RequestID string
+}
+
+func (r *Repository) CreateSaga(ctx context.Context, rec *SagaRecord) error {
+	query := `
+		INSERT INTO sagas (saga_type, status, step, data, actor, request_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+	err := r.db.QueryRowContext(ctx, query,
+		rec.Type, rec.Status, rec.Step, string(rec.Data), rec.Actor, rec.RequestID,
+	).Scan(&rec.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create saga: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) SaveSaga(ctx context.Context, rec *SagaRecord) error {
+	query := `
+		UPDATE sagas
+		SET status = $2, step = $3, data = $4, last_error = NULLIF($5, ''), updated_at = NOW()
+		WHERE id = $1
+	`
+	_, err := r.db.ExecContext(ctx, query, rec.ID, rec.Status, rec.Step, string(rec.Data), rec.LastError)
+	if err != nil {
+		return fmt.Errorf("failed to save saga %d: %w", rec.ID, err)
+	}
+	return nil
+}
+
+// ClaimStaleSagas returns up to limit unfinished sagas of the given type that
+// have not made progress since staleBefore, i.e. whose process has stopped.
+// Claiming touches updated_at, so other replicas leave them alone while they
+// are being resumed.
+func (r *Repository) ClaimStaleSagas(ctx context.Context, sagaType string, staleBefore time.Time, limit int) ([]SagaRecord, error) {
+	query := `
+		UPDATE sagas SET updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM sagas
+			WHERE saga_type = $1
+			AND status IN ('running', 'compensating')
+			AND updated_at < $2
+			ORDER BY id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, saga_type, status, step, data, COALESCE(last_error, ''), actor, request_id
+	`
+	rows, err := r.db.QueryContext(ctx, query, sagaType, staleBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim sagas: %w", err)
+	}
+	defer rows.Close()
+
+	var sagas []SagaRecord
+	for rows.Next() {
+		var rec SagaRecord
+		var data string
+		if err := rows.Scan(&rec.ID, &rec.Type, &rec.Status, &rec.Step, &data, &rec.LastError, &rec.Actor, &rec.RequestID); err != nil {
+			return nil, fmt.Errorf("failed to scan saga: %w", err)
+		}
+		rec.Data = []byte(data)
+		sagas = append(sagas, rec)
+	}
+	return sagas, rows.Err()
+}
+
+// GetBookingIDBySaga returns the booking held by the saga, or ErrNotFound
+// when the saga has not created one.
+func (r *Repository) GetBookingIDBySaga(ctx context.Context, sagaID int64) (int, error) {
+	var id int
+	err := r.db.QueryRowContext(ctx, `SELECT id FROM bookings WHERE saga_id = $1`, sagaID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get saga booking: %w", err)
+	}
+	return id, nil
+}
@@ -0,0 +1,187 @@
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/repository"
+	"hotel-booking-system/internal/package/requestctx"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Step is one unit of work of a saga. Compensate undoes Action and is nil for
+// steps with nothing to undo. Both run again after a restart if they were
+// interrupted, and Compensate also runs for the step that failed, since it
+// may have taken effect before failing (e.g. a timeout after a commit), so
+// both must be safe to repeat and to run when nothing was done.
+type Step[T any] struct {
+	Name       string
+	Timeout    time.Duration
+	Action     func(ctx context.Context, sagaID int64, data *T) error
+	Compensate func(ctx context.Context, sagaID int64, data *T) error
+}
+
+type Definition[T any] struct {
+	Type  string
+	Steps []Step[T]
+}
+
+// Store persists saga records; *repository.Repository implements it.
+type Store interface {
+	CreateSaga(ctx context.Context, rec *repository.SagaRecord) error
+	SaveSaga(ctx context.Context, rec *repository.SagaRecord) error
+	ClaimStaleSagas(ctx context.Context, sagaType string, staleBefore time.Time, limit int) ([]repository.SagaRecord, error)
+}
+
+// Orchestrator runs sagas of one definition and persists their progress
+// after every step, so that a saga interrupted by a restart can be resumed.
+type Orchestrator[T any] struct {
+	repo       Store
+	def        Definition[T]
+	staleAfter time.Duration
+}
+
+// NewOrchestrator creates an orchestrator that treats sagas without progress
+// for staleAfter as abandoned. staleAfter must be longer than any step
+// timeout.
+func NewOrchestrator[T any](repo Store, def Definition[T], staleAfter time.Duration) *Orchestrator[T] {
+	return &Orchestrator[T]{
+		repo:       repo,
+		def:        def,
+		staleAfter: staleAfter,
+	}
+}
+
+// Run persists a new saga for data and executes it. When a step fails, the
+// steps that took effect are compensated in reverse order and the step's
+// error is returned.
+func (o *Orchestrator[T]) Run(ctx context.Context, data T) (T, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return data, fmt.Errorf("failed to marshal saga data: %w", err)
+	}
+
+	rec := &repository.SagaRecord{
+		Type:      o.def.Type,
+		Status:    repository.SagaRunning,
+		Data:      payload,
+		Actor:     requestctx.Actor(ctx),
+		RequestID: requestctx.RequestID(ctx),
+	}
+	if err := o.repo.CreateSaga(ctx, rec); err != nil {
+		return data, err
+	}
+
+	// Once started, the saga has to finish or compensate even if the caller
+	// goes away.
+	err = o.execute(context.WithoutCancel(ctx), rec, &data)
+	return data, err
+}
+
+// Resume claims up to limit abandoned sagas, continues the running ones and
+// finishes compensating the rest. It returns how many sagas it picked up.
+func (o *Orchestrator[T]) Resume(ctx context.Context, limit int) (int, error) {
+	records, err := o.repo.ClaimStaleSagas(ctx, o.def.Type, time.Now().Add(-o.staleAfter), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range records {
+		rec := &records[i]
+
+		var data T
+		if err := json.Unmarshal(rec.Data, &data); err != nil {
+			logrus.Errorf("Saga %d (%s) has unreadable data: %v", rec.ID, rec.Type, err)
+			continue
+		}
+
+		sagaCtx := requestctx.WithRequestID(ctx, rec.RequestID)
+		sagaCtx = requestctx.WithActor(sagaCtx, rec.Actor)
+
+		logrus.Infof("Resuming saga %d (%s) at step %d, status %s", rec.ID, rec.Type, rec.Step, rec.Status)
+		if err := o.execute(sagaCtx, rec, &data); err != nil {
+			logrus.Warnf("Resumed saga %d (%s) failed: %v", rec.ID, rec.Type, err)
+		}
+	}
+
+	return len(records), nil
+}
+
+func (o *Orchestrator[T]) execute(ctx context.Context, rec *repository.SagaRecord, data *T) error {
+	if rec.Status == repository.SagaCompensating {
+		return o.compensate(ctx, rec, data)
+	}
+
+	for rec.Step < len(o.def.Steps) {
+		step := o.def.Steps[rec.Step]
+
+		if err := runStep(ctx, step.Timeout, step.Action, rec.ID, data); err != nil {
+			stepErr := fmt.Errorf("saga step %s failed: %w", step.Name, err)
+			logrus.Warnf("Saga %d (%s): %v, compensating", rec.ID, rec.Type, stepErr)
+
+			rec.Status = repository.SagaCompensating
+			rec.Step++
+			rec.LastError = stepErr.Error()
+			if err := o.save(ctx, rec, data); err != nil {
+				return err
+			}
+			if err := o.compensate(ctx, rec, data); err != nil {
+				logrus.Errorf("Saga %d (%s): %v", rec.ID, rec.Type, err)
+			}
+			return stepErr
+		}
+
+		rec.Step++
+		if rec.Step == len(o.def.Steps) {
+			rec.Status = repository.SagaCompleted
+		}
+		if err := o.save(ctx, rec, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compensate undoes the steps that took effect, last first. On failure the
+// saga stays in the compensating state and is retried by Resume.
+func (o *Orchestrator[T]) compensate(ctx context.Context, rec *repository.SagaRecord, data *T) error {
+	for rec.Step > 0 {
+		step := o.def.Steps[rec.Step-1]
+
+		if step.Compensate != nil {
+			if err := runStep(ctx, step.Timeout, step.Compensate, rec.ID, data); err != nil {
+				return fmt.Errorf("failed to compensate saga step %s: %w", step.Name, err)
+			}
+		}
+
+		rec.Step--
+		if err := o.save(ctx, rec, data); err != nil {
+			return err
+		}
+	}
+
+	rec.Status = repository.SagaCompensated
+	return o.save(ctx, rec, data)
+}
+
+func (o *Orchestrator[T]) save(ctx context.Context, rec *repository.SagaRecord, data *T) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal saga data: %w", err)
+	}
+	rec.Data = payload
+	return o.repo.SaveSaga(ctx, rec)
+}
+
+func runStep[T any](ctx context.Context, timeout time.Duration, fn func(context.Context, int64, *T) error, sagaID int64, data *T) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return fn(ctx, sagaID, data)
+}
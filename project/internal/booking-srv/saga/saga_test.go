@@ -0,0 +1,269 @@
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/repository"
+	"hotel-booking-system/internal/package/requestctx"
+)
+
+// store keeps saga records in memory and remembers every saved state.
+type store struct {
+	records map[int64]*repository.SagaRecord
+	saved   []repository.SagaRecord
+}
+
+func newStore() *store {
+	return &store{records: make(map[int64]*repository.SagaRecord)}
+}
+
+func (s *store) CreateSaga(ctx context.Context, rec *repository.SagaRecord) error {
+	rec.ID = int64(len(s.records) + 1)
+	saved := *rec
+	s.records[rec.ID] = &saved
+	return nil
+}
+
+func (s *store) SaveSaga(ctx context.Context, rec *repository.SagaRecord) error {
+	saved := *rec
+	s.records[rec.ID] = &saved
+	s.saved = append(s.saved, saved)
+	return nil
+}
+
+func (s *store) ClaimStaleSagas(ctx context.Context, sagaType string, staleBefore time.Time, limit int) ([]repository.SagaRecord, error) {
+	var claimed []repository.SagaRecord
+	for id := int64(1); id <= int64(len(s.records)) && len(claimed) < limit; id++ {
+		rec := s.records[id]
+		if rec.Type == sagaType && (rec.Status == repository.SagaRunning || rec.Status == repository.SagaCompensating) {
+			claimed = append(claimed, *rec)
+		}
+	}
+	return claimed, nil
+}
+
+type testData struct {
+	Done []string `json:"done"`
+}
+
+var errStep = errors.New("step failed")
+
+// recorder builds a definition of the named steps and logs every action and
+// compensation it runs. Steps listed in failing return errStep, and so do
+// the compensations listed in failingUndo.
+type recorder struct {
+	calls       []string
+	failing     []string
+	failingUndo []string
+}
+
+func (r *recorder) definition(names ...string) Definition[testData] {
+	def := Definition[testData]{Type: "test"}
+	for _, name := range names {
+		def.Steps = append(def.Steps, Step[testData]{
+			Name: name,
+			Action: func(ctx context.Context, _ int64, d *testData) error {
+				r.calls = append(r.calls, name)
+				if slices.Contains(r.failing, name) {
+					return errStep
+				}
+				d.Done = append(d.Done, name)
+				return nil
+			},
+			Compensate: func(ctx context.Context, _ int64, d *testData) error {
+				r.calls = append(r.calls, "undo "+name)
+				if slices.Contains(r.failingUndo, name) {
+					return errStep
+				}
+				return nil
+			},
+		})
+	}
+	return def
+}
+
+func TestRunCompletes(t *testing.T) {
+	s := newStore()
+	r := &recorder{}
+	o := NewOrchestrator(s, r.definition("quote", "hold", "confirm"), time.Minute)
+
+	data, err := o.Run(context.Background(), testData{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"quote", "hold", "confirm"}; !slices.Equal(r.calls, want) || !slices.Equal(data.Done, want) {
+		t.Errorf("calls %v, data %v, want %v", r.calls, data.Done, want)
+	}
+
+	// Progress is saved after every step, with the data so far.
+	if len(s.saved) != 3 {
+		t.Fatalf("saved %d times, want 3", len(s.saved))
+	}
+	for i, rec := range s.saved {
+		var saved testData
+		if err := json.Unmarshal(rec.Data, &saved); err != nil {
+			t.Fatal(err)
+		}
+		if rec.Step != i+1 || len(saved.Done) != i+1 {
+			t.Errorf("save %d at step %d with %v", i+1, rec.Step, saved.Done)
+		}
+	}
+	if rec := s.records[1]; rec.Status != repository.SagaCompleted || rec.Step != 3 {
+		t.Errorf("saga %s at step %d", rec.Status, rec.Step)
+	}
+}
+
+func TestFailedStepCompensatesInReverse(t *testing.T) {
+	s := newStore()
+	r := &recorder{failing: []string{"pay"}}
+	o := NewOrchestrator(s, r.definition("quote", "hold", "pay", "confirm"), time.Minute)
+
+	_, err := o.Run(context.Background(), testData{})
+	if !errors.Is(err, errStep) {
+		t.Fatalf("got %v, want the step's error", err)
+	}
+
+	// The failed step is compensated too, since it may have taken effect.
+	want := []string{"quote", "hold", "pay", "undo pay", "undo hold", "undo quote"}
+	if !slices.Equal(r.calls, want) {
+		t.Errorf("calls %v, want %v", r.calls, want)
+	}
+	rec := s.records[1]
+	if rec.Status != repository.SagaCompensated || rec.Step != 0 {
+		t.Errorf("saga %s at step %d", rec.Status, rec.Step)
+	}
+	if !strings.Contains(rec.LastError, "saga step pay failed") {
+		t.Errorf("last error %q", rec.LastError)
+	}
+}
+
+func TestStepWithoutCompensation(t *testing.T) {
+	s := newStore()
+	r := &recorder{failing: []string{"hold"}}
+	def := r.definition("quote", "hold")
+	def.Steps[0].Compensate = nil
+	o := NewOrchestrator(s, def, time.Minute)
+
+	if _, err := o.Run(context.Background(), testData{}); !errors.Is(err, errStep) {
+		t.Fatalf("got %v, want the step's error", err)
+	}
+	if want := []string{"quote", "hold", "undo hold"}; !slices.Equal(r.calls, want) {
+		t.Errorf("calls %v, want %v", r.calls, want)
+	}
+	if rec := s.records[1]; rec.Status != repository.SagaCompensated {
+		t.Errorf("saga %s", rec.Status)
+	}
+}
+
+func TestResumeRunningSaga(t *testing.T) {
+	s := newStore()
+	r := &recorder{}
+	o := NewOrchestrator(s, r.definition("quote", "hold", "confirm"), time.Minute)
+
+	// A process stopped after persisting the second step.
+	payload, _ := json.Marshal(testData{Done: []string{"quote", "hold"}})
+	if err := s.CreateSaga(context.Background(), &repository.SagaRecord{
+		Type:      "test",
+		Status:    repository.SagaRunning,
+		Step:      2,
+		Data:      payload,
+		Actor:     "guest",
+		RequestID: "req-1",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var actor, requestID string
+	o.def.Steps[2].Action = func(ctx context.Context, _ int64, d *testData) error {
+		actor, requestID = requestctx.Actor(ctx), requestctx.RequestID(ctx)
+		r.calls = append(r.calls, "confirm")
+		d.Done = append(d.Done, "confirm")
+		return nil
+	}
+
+	n, err := o.Resume(context.Background(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("resumed %d sagas, want 1", n)
+	}
+	if want := []string{"confirm"}; !slices.Equal(r.calls, want) {
+		t.Errorf("calls %v, want %v", r.calls, want)
+	}
+	if actor != "guest" || requestID != "req-1" {
+		t.Errorf("resumed as %q with request ID %q", actor, requestID)
+	}
+
+	rec := s.records[1]
+	var data testData
+	if err := json.Unmarshal(rec.Data, &data); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Status != repository.SagaCompleted || rec.Step != 3 || !slices.Equal(data.Done, []string{"quote", "hold", "confirm"}) {
+		t.Errorf("saga %s at step %d with %v", rec.Status, rec.Step, data.Done)
+	}
+
+	// A completed saga is not picked up again.
+	if n, err := o.Resume(context.Background(), 10); err != nil || n != 0 {
+		t.Errorf("second resume picked up %d sagas: %v", n, err)
+	}
+}
+
+func TestResumeCompensatingSaga(t *testing.T) {
+	s := newStore()
+	r := &recorder{failing: []string{"pay"}, failingUndo: []string{"hold"}}
+	o := NewOrchestrator(s, r.definition("quote", "hold", "pay"), time.Minute)
+
+	if _, err := o.Run(context.Background(), testData{}); !errors.Is(err, errStep) {
+		t.Fatalf("got %v, want the step's error", err)
+	}
+	// The failed compensation leaves the saga for Resume, at the step whose
+	// compensation did not go through.
+	rec := s.records[1]
+	if rec.Status != repository.SagaCompensating || rec.Step != 2 {
+		t.Fatalf("saga %s at step %d", rec.Status, rec.Step)
+	}
+
+	r.calls, r.failingUndo = nil, nil
+	if _, err := o.Resume(context.Background(), 10); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"undo hold", "undo quote"}; !slices.Equal(r.calls, want) {
+		t.Errorf("calls %v, want %v", r.calls, want)
+	}
+	if rec := s.records[1]; rec.Status != repository.SagaCompensated || rec.Step != 0 {
+		t.Errorf("saga %s at step %d", rec.Status, rec.Step)
+	}
+}
+
+func TestRunIgnoresCallerCancellation(t *testing.T) {
+	s := newStore()
+	r := &recorder{}
+	ctx, cancel := context.WithCancel(context.Background())
+	def := r.definition("hold", "confirm")
+	hold := def.Steps[0].Action
+	def.Steps[0].Action = func(stepCtx context.Context, id int64, d *testData) error {
+		cancel()
+		return hold(stepCtx, id, d)
+	}
+	def.Steps[1].Action = func(stepCtx context.Context, _ int64, d *testData) error {
+		r.calls = append(r.calls, "confirm")
+		return stepCtx.Err()
+	}
+	def.Steps[1].Timeout = time.Second
+	o := NewOrchestrator(s, def, time.Minute)
+
+	if _, err := o.Run(ctx, testData{}); err != nil {
+		t.Fatalf("saga stopped with the caller: %v", err)
+	}
+	if rec := s.records[1]; rec.Status != repository.SagaCompleted {
+		t.Errorf("saga %s", rec.Status)
+	}
+}
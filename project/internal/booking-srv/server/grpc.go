@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/repository"
+	"hotel-booking-system/internal/booking-srv/stg"
+	"hotel-booking-system/internal/package/apivalidate"
+	"hotel-booking-system/internal/package/apperr"
+	"hotel-booking-system/internal/package/middleware"
+	api "hotel-booking-system/package/api/stable"
+	bookingv1 "hotel-booking-system/package/proto/booking/stable"
+
+	"github.com/sirupsen/logrus"
+)
+
+const dateLayout = "2006-01-02"
+
+func (server *BookingServer) CreateBooking(ctx context.Context, req *bookingv1.CreateBookingRequest) (*bookingv1.CreateBookingResponse, error) {
+	middleware.Logger(ctx).WithFields(logrus.Fields{
+		"user_id":  req.UserId,
+		"hotel_id": req.HotelId,
+	}).Info("CreateBooking gRPC request")
+
+	checkIn, err := time.Parse(dateLayout, req.CheckInDate)
+	if err != nil {
+		return nil, dateFieldError("check_in_date")
+	}
+	checkOut, err := time.Parse(dateLayout, req.CheckOutDate)
+	if err != nil {
+		return nil, dateFieldError("check_out_date")
+	}
+
+	booking := api.CreateBookingRequest{
+		UserID:        int(req.UserId),
+		HotelID:       int(req.HotelId),
+		RoomTypeID:    int(req.RoomTypeId),
+		CheckInDate:   checkIn,
+		CheckOutDate:  checkOut,
+		GuestsCount:   int(req.GuestsCount),
+		LoyaltyPoints: int(req.LoyaltyPoints),
+	}
+	if err := apivalidate.CreateBookingRequest(booking, time.Now()); err != nil {
+		return nil, err
+	}
+
+	bookingID, err := server.Src.CreateBooking(ctx, stg.BookingInfo{
+		UserID:       booking.UserID,
+		HotelID:      booking.HotelID,
+		RoomTypeID:   booking.RoomTypeID,
+		CheckInDate:  booking.CheckInDate,
+		CheckOutDate: booking.CheckOutDate,
+		GuestsCount:  booking.GuestsCount,
+		RedeemPoints: booking.LoyaltyPoints,
+	})
+	if err != nil {
+		middleware.Logger(ctx).WithError(err).Error("Failed to create booking")
+		return nil, err
+	}
+
+	return &bookingv1.CreateBookingResponse{
+		BookingId: int32(bookingID),
+		Status:    repository.StatusConfirmed,
+	}, nil
+}
+
+func dateFieldError(field string) error {
+	return apperr.Invalid([]apperr.FieldError{{Field: field, Message: "must be a YYYY-MM-DD date"}})
+}
+
+func (server *BookingServer) GetBooking(ctx context.Context, req *bookingv1.GetBookingRequest) (*bookingv1.GetBookingResponse, error) {
+	booking, err := server.Src.GetBooking(ctx, int(req.BookingId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookingv1.GetBookingResponse{
+		Booking: bookingToProto(booking),
+	}, nil
+}
+
+func (server *BookingServer) ListUserBookings(ctx context.Context, req *bookingv1.ListUserBookingsRequest) (*bookingv1.ListBookingsResponse, error) {
+	bookings, err := server.Src.GetAllClientBookings(ctx, int(req.UserId))
+	if err != nil {
+		middleware.Logger(ctx).WithError(err).Error("Failed to list user bookings")
+		return nil, err
+	}
+	return bookingsToProto(bookings), nil
+}
+
+func (server *BookingServer) ListHotelBookings(ctx context.Context, req *bookingv1.ListHotelBookingsRequest) (*bookingv1.ListBookingsResponse, error) {
+	bookings, err := server.Src.GetAllHotelBookings(ctx, int(req.HotelId))
+	if err != nil {
+		middleware.Logger(ctx).WithError(err).Error("Failed to list hotel bookings")
+		return nil, err
+	}
+	return bookingsToProto(bookings), nil
+}
+
+func (server *BookingServer) CancelBooking(ctx context.Context, req *bookingv1.CancelBookingRequest) (*bookingv1.CancelBookingResponse, error) {
+	middleware.Logger(ctx).WithField("booking_id", req.BookingId).Info("CancelBooking gRPC request")
+
+	if err := server.Src.CancelBooking(ctx, int(req.BookingId)); err != nil {
+		middleware.Logger(ctx).WithError(err).Error("Failed to cancel booking")
+		return nil, err
+	}
+
+	return &bookingv1.CancelBookingResponse{
+		BookingId: req.BookingId,
+		Status:    repository.StatusCancelled,
+	}, nil
+}
+
+func bookingToProto(b *repository.Booking) *bookingv1.Booking {
+	return &bookingv1.Booking{
+		Id:              int32(b.ID),
+		UserId:          int32(b.UserID),
+		HotelId:         int32(b.HotelID),
+		RoomId:          int32(b.RoomID),
+		CheckInDate:     b.CheckInDate.Format(dateLayout),
+		CheckOutDate:    b.CheckOutDate.Format(dateLayout),
+		GuestsCount:     int32(b.GuestsCount),
+		TotalPrice:      b.TotalPrice,
+		LoyaltyDiscount: b.LoyaltyDiscount,
+		Status:          b.Status,
+		HotelName:       b.HotelName,
+		HotelAddress:    b.HotelAddress,
+		HotelPhone:      b.HotelPhone,
+		RoomTypeName:    b.RoomTypeName,
+	}
+}
+
+func bookingsToProto(bookings []repository.Booking) *bookingv1.ListBookingsResponse {
+	resp := &bookingv1.ListBookingsResponse{}
+	for i := range bookings {
+		resp.Bookings = append(resp.Bookings, bookingToProto(&bookings[i]))
+	}
+	return resp
+}
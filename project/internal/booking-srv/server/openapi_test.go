@@ -0,0 +1,79 @@
+package server
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"hotel-booking-system/internal/booking-srv/repository"
+	"hotel-booking-system/internal/booking-srv/stg"
+	"hotel-booking-system/internal/package/apperr"
+	"hotel-booking-system/internal/package/health"
+	"hotel-booking-system/package/api/openapi"
+	api "hotel-booking-system/package/api/stable"
+)
+
+func TestRoutesMatchOpenAPI(t *testing.T) {
+	server := NewBookingServer(nil, nil)
+	server.SetServer()
+
+	var routes []string
+	for _, rt := range server.routes() {
+		routes = append(routes, rt.pattern)
+	}
+	if err := openapi.CheckRoutes(openapi.BookingSpec, server.Mux, routes); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSchemasMatchTypes(t *testing.T) {
+	tests := []struct {
+		schema string
+		value  any
+	}{
+		{"CreateBookingRequest", api.CreateBookingRequest{}},
+		{"BookingActionRequest", api.BookingActionRequest{}},
+		{"ModifyBookingRequest", api.ModifyBookingRequest{}},
+		{"CreateReviewRequest", api.CreateReviewRequest{}},
+		{"ReviewIDResponse", api.CreateReviewResponse{}},
+		{"CalendarTokenRequest", api.CalendarTokenRequest{}},
+		{"CalendarTokenResponse", api.CalendarTokenResponse{}},
+		{"ImportCalendarResponse", api.ImportCalendarResponse{}},
+		{"Booking", repository.Booking{}},
+		{"BookingEvent", repository.BookingEvent{}},
+		{"LoyaltyStatus", stg.LoyaltyStatus{}},
+		{"Problem", apperr.Problem{}},
+		{"FieldError", apperr.FieldError{}},
+		{"Readiness", health.Report{}},
+		{"DependencyStatus", health.Result{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schema, func(t *testing.T) {
+			if err := openapi.CheckSchema(openapi.BookingSpec, tt.schema, tt.value); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestServesOpenAPI(t *testing.T) {
+	server := NewBookingServer(nil, nil)
+	server.SetServer()
+
+	rec := httptest.NewRecorder()
+	server.Mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	body, _ := io.ReadAll(rec.Body)
+	if !bytes.Equal(body, openapi.BookingSpec) {
+		t.Error("served document differs from the embedded spec")
+	}
+}
@@ -1,32 +1,127 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"hotel-booking-system/internal/booking-srv/exceptions"
 	"hotel-booking-system/internal/booking-srv/stg"
+	"hotel-booking-system/internal/package/apivalidate"
+	"hotel-booking-system/internal/package/apperr"
+	"hotel-booking-system/internal/package/health"
+	"hotel-booking-system/internal/package/ical"
+	"hotel-booking-system/internal/package/metrics"
+	"hotel-booking-system/internal/package/middleware"
+	"hotel-booking-system/internal/package/requestctx"
+	"hotel-booking-system/package/api/openapi"
 	api "hotel-booking-system/package/api/stable"
+	bookingv1 "hotel-booking-system/package/proto/booking/stable"
 )
 
 type BookingServer struct {
-	Src *stg.Storage
-	Mux *http.ServeMux
+	Src       *stg.Storage
+	Mux       *http.ServeMux
+	Readiness *health.Checker
+	bookingv1.UnimplementedBookingServiceServer
 }
 
-func NewBookingServer(service *stg.Storage) *BookingServer {
+type route struct {
+	pattern string
+	handler http.HandlerFunc
+}
+
+func NewBookingServer(service *stg.Storage, readiness *health.Checker) *BookingServer {
 	return &BookingServer{
-		Src: service,
-		Mux: http.NewServeMux(),
+		Src:       service,
+		Mux:       http.NewServeMux(),
+		Readiness: readiness,
 	}
 }
 
+const defaultTimeout = 10 * time.Second
+
+// routeTimeouts overrides defaultTimeout for routes that call the hotel
+// service or parse large bodies.
+var routeTimeouts = map[string]time.Duration{
+	"POST /api/create_booking":       30 * time.Second,
+	"POST /api/modify_booking":       30 * time.Second,
+	"POST /api/create_review":        20 * time.Second,
+	"POST /api/import_room_calendar": 30 * time.Second,
+}
+
 func (server *BookingServer) SetServer() {
-	server.Mux.HandleFunc("POST /api/create_booking", server.CreateBookingHandler)
-	server.Mux.HandleFunc("GET /api/get_all_client_bookings", server.GetAllClientBookingsHandler)
+	for _, rt := range server.routes() {
+		timeout, ok := routeTimeouts[rt.pattern]
+		if !ok {
+			timeout = defaultTimeout
+		}
+		server.Mux.Handle(rt.pattern, middleware.Timeout(timeout)(rt.handler))
+	}
+}
 
-	server.Mux.HandleFunc("GET /live", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
+// routes lists every HTTP endpoint of the service. Tests check it against
+// the OpenAPI spec, so new routes have to be documented there.
+func (server *BookingServer) routes() []route {
+	return []route{
+		{"POST /api/create_booking", withAuditContext(server.CreateBookingHandler)},
+		{"GET /api/get_all_client_bookings", server.GetAllClientBookingsHandler},
+		{"POST /api/check_in", withAuditContext(server.CheckInHandler)},
+		{"POST /api/check_out", withAuditContext(server.CheckOutHandler)},
+		{"POST /api/cancel_booking", withAuditContext(server.CancelBookingHandler)},
+		{"POST /api/modify_booking", withAuditContext(server.ModifyBookingHandler)},
+		{"GET /api/booking_history", server.GetBookingHistoryHandler},
+		{"GET /api/loyalty_balance", server.GetLoyaltyBalanceHandler},
+		{"POST /api/calendar_token", server.CreateCalendarTokenHandler},
+		{"POST /api/import_room_calendar", server.ImportRoomCalendarHandler},
+		{"GET /calendar/users/{token}", server.UserCalendarHandler},
+		{"GET /calendar/rooms/{room_id}", server.RoomCalendarHandler},
+		{"POST /api/create_review", server.CreateReviewHandler},
+		{"GET /live", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}},
+		{"GET /ready", server.Readiness.ServeHTTP},
+		{"GET /openapi.json", openapi.Handler(openapi.BookingSpec)},
+		{"GET /metrics", metrics.Handler().ServeHTTP},
+	}
+}
+
+// withAuditContext stores the caller's X-Actor header in the request context,
+// so that booking changes are attributed in the history. The request ID is
+// set by middleware.RequestID.
+func withAuditContext(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		next(w, r.WithContext(requestctx.WithActor(r.Context(), r.Header.Get("X-Actor"))))
+	}
+}
+
+// Roles of the X-Actor header "<role>:<id>" set by the gateway. A user's id
+// is their user ID and a manager's id is their hotel.
+const (
+	roleAdmin   = "admin"
+	roleManager = "manager"
+	roleUser    = "user"
+)
+
+// requireUser lets through the user userID only.
+func requireUser(r *http.Request, userID int) error {
+	if role, id, _ := strings.Cut(r.Header.Get("X-Actor"), ":"); role == roleUser && id == strconv.Itoa(userID) {
+		return nil
+	}
+	return fmt.Errorf("%w: only user %d may do this", exceptions.ErrPermissionDenied, userID)
+}
+
+// requireManager lets through admins and the managers of hotelID.
+func requireManager(r *http.Request, hotelID int) error {
+	role, id, _ := strings.Cut(r.Header.Get("X-Actor"), ":")
+	if role == roleAdmin || role == roleManager && id == strconv.Itoa(hotelID) {
+		return nil
+	}
+	return fmt.Errorf("%w: only managers of hotel %d may do this", exceptions.ErrPermissionDenied, hotelID)
 }
 
 func (server *BookingServer) CreateBookingHandler(w http.ResponseWriter, r *http.Request) {
@@ -38,7 +133,11 @@ func (server *BookingServer) CreateBookingHandler(w http.ResponseWriter, r *http
 
 	var req api.CreateBookingRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeInvalidJSON(w, http.StatusBadRequest)
+		apperr.WriteHTTP(w, decodeError(err))
+		return
+	}
+	if err := apivalidate.CreateBookingRequest(req, time.Now()); err != nil {
+		apperr.WriteHTTP(w, err)
 		return
 	}
 
@@ -49,11 +148,12 @@ func (server *BookingServer) CreateBookingHandler(w http.ResponseWriter, r *http
 		CheckInDate:  req.CheckInDate,
 		CheckOutDate: req.CheckOutDate,
 		GuestsCount:  req.GuestsCount,
+		RedeemPoints: req.LoyaltyPoints,
 	}
 
 	bookingId, err := server.Src.CreateBooking(r.Context(), bookingInfo)
 	if err != nil {
-		writeInvalidJSONError(w, err)
+		apperr.WriteHTTP(w, err)
 		return
 	}
 
@@ -73,13 +173,13 @@ func (server *BookingServer) GetAllClientBookingsHandler(w http.ResponseWriter,
 
 	var userID int
 	if err := json.NewDecoder(r.Body).Decode(&userID); err != nil {
-		writeInvalidJSON(w, http.StatusBadRequest)
+		apperr.WriteHTTP(w, decodeError(err))
 		return
 	}
 
 	bookings, err := server.Src.GetAllClientBookings(r.Context(), userID)
 	if err != nil {
-		writeInvalidJSONError(w, err)
+		apperr.WriteHTTP(w, err)
 		return
 	}
 
@@ -87,21 +187,249 @@ func (server *BookingServer) GetAllClientBookingsHandler(w http.ResponseWriter,
 	_ = json.NewEncoder(w).Encode(bookings)
 }
 
-func writeInvalidJSON(w http.ResponseWriter, status int) {
-	w.WriteHeader(status)
-	_ = json.NewEncoder(w).Encode(struct {
-		Error string `json:"error"`
-	}{
-		Error: "invalid json",
+func (server *BookingServer) CheckInHandler(w http.ResponseWriter, r *http.Request) {
+	server.bookingActionHandler(w, r, server.Src.CheckIn)
+}
+
+func (server *BookingServer) CheckOutHandler(w http.ResponseWriter, r *http.Request) {
+	server.bookingActionHandler(w, r, server.Src.CheckOut)
+}
+
+func (server *BookingServer) CancelBookingHandler(w http.ResponseWriter, r *http.Request) {
+	server.bookingActionHandler(w, r, server.Src.CancelBooking)
+}
+
+func (server *BookingServer) bookingActionHandler(w http.ResponseWriter, r *http.Request, action func(context.Context, int) error) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	var req api.BookingActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apperr.WriteHTTP(w, decodeError(err))
+		return
+	}
+	if err := apivalidate.BookingActionRequest(req); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	if err := action(r.Context(), req.BookingID); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]int{
+		"booking_id": req.BookingID,
+	})
+}
+
+func (server *BookingServer) ModifyBookingHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	var req api.ModifyBookingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apperr.WriteHTTP(w, decodeError(err))
+		return
+	}
+	if err := apivalidate.ModifyBookingRequest(req, time.Now()); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	err := server.Src.ModifyBooking(r.Context(), req.BookingID, stg.ModifyInfo{
+		CheckInDate:  req.CheckInDate,
+		CheckOutDate: req.CheckOutDate,
+		GuestsCount:  req.GuestsCount,
+	})
+	if err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]int{
+		"booking_id": req.BookingID,
+	})
+}
+
+func (server *BookingServer) CreateReviewHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	var req api.CreateReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apperr.WriteHTTP(w, decodeError(err))
+		return
+	}
+	if err := apivalidate.CreateReviewRequest(req); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	reviewID, err := server.Src.CreateReview(r.Context(), stg.ReviewInfo{
+		UserID:    req.UserID,
+		BookingID: req.BookingID,
+		Rating:    req.Rating,
+		Text:      req.Text,
+	})
+	if err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(api.CreateReviewResponse{ReviewID: reviewID})
+}
+
+func (server *BookingServer) GetLoyaltyBalanceHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	userID, err := strconv.Atoi(r.URL.Query().Get("user_id"))
+	if err != nil {
+		apperr.WriteHTTP(w, invalidParam("user_id"))
+		return
+	}
+
+	status, err := server.Src.GetLoyaltyStatus(r.Context(), userID)
+	if err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+func (server *BookingServer) CreateCalendarTokenHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	var req api.CalendarTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apperr.WriteHTTP(w, decodeError(err))
+		return
+	}
+	if err := apivalidate.CalendarTokenRequest(req); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+	if err := requireUser(r, req.UserID); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	token, err := server.Src.CreateCalendarToken(r.Context(), req.UserID)
+	if err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(api.CalendarTokenResponse{
+		Token: token,
+		URL:   "/calendar/users/" + token + ".ics",
 	})
 }
 
-func writeInvalidJSONError(w http.ResponseWriter, err error) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusBadRequest)
-	_ = json.NewEncoder(w).Encode(struct {
-		Error string `json:"error"`
-	}{
-		Error: err.Error(),
+func (server *BookingServer) UserCalendarHandler(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimSuffix(r.PathValue("token"), ".ics")
+
+	calendar, err := server.Src.GetUserCalendar(r.Context(), token)
+	if err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	writeCalendar(w, "My bookings", calendar)
+}
+
+func (server *BookingServer) RoomCalendarHandler(w http.ResponseWriter, r *http.Request) {
+	roomID, err := strconv.Atoi(strings.TrimSuffix(r.PathValue("room_id"), ".ics"))
+	if err != nil {
+		apperr.WriteHTTP(w, invalidParam("room_id"))
+		return
+	}
+
+	calendar, err := server.Src.GetRoomCalendar(r.Context(), roomID)
+	if err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	writeCalendar(w, fmt.Sprintf("Room %d", roomID), calendar)
+}
+
+// ImportRoomCalendarHandler takes a raw .ics body and the hotel_id,
+// room_type_id, room_id and source query parameters.
+func (server *BookingServer) ImportRoomCalendarHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	query := r.URL.Query()
+	hotelID, err := strconv.Atoi(query.Get("hotel_id"))
+	if err != nil {
+		apperr.WriteHTTP(w, invalidParam("hotel_id"))
+		return
+	}
+	roomTypeID, err := strconv.Atoi(query.Get("room_type_id"))
+	if err != nil {
+		apperr.WriteHTTP(w, invalidParam("room_type_id"))
+		return
+	}
+	roomID, err := strconv.Atoi(query.Get("room_id"))
+	if err != nil {
+		apperr.WriteHTTP(w, invalidParam("room_id"))
+		return
+	}
+	if err := requireManager(r, hotelID); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	imported, err := server.Src.ImportRoomCalendar(r.Context(), hotelID, roomTypeID, roomID, query.Get("source"), r.Body)
+	if err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(api.ImportCalendarResponse{
+		RoomID:   roomID,
+		Imported: imported,
 	})
 }
+
+func (server *BookingServer) GetBookingHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	bookingID, err := strconv.Atoi(r.URL.Query().Get("booking_id"))
+	if err != nil {
+		apperr.WriteHTTP(w, invalidParam("booking_id"))
+		return
+	}
+
+	history, err := server.Src.GetBookingHistory(r.Context(), bookingID)
+	if err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(history)
+}
+
+func writeCalendar(w http.ResponseWriter, name string, calendar []ical.Event) {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_ = ical.Write(w, name, calendar, time.Now())
+}
+
+// decodeError reports a body that could not be decoded, telling bodies cut
+// off by the size limit apart from malformed JSON.
+func decodeError(err error) error {
+	if middleware.IsBodyTooLarge(err) {
+		return middleware.ErrBodyTooLarge
+	}
+	return exceptions.ErrInvalidJSON
+}
+
+func invalidParam(name string) error {
+	return fmt.Errorf("%w: invalid %s", exceptions.ErrInvalidRequest, name)
+}
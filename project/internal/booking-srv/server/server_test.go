@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"hotel-booking-system/internal/booking-srv/hotelclient/fakehotel"
+	"hotel-booking-system/internal/booking-srv/repository"
+	"hotel-booking-system/internal/booking-srv/repository/memory"
+	"hotel-booking-system/internal/booking-srv/stg"
+	kafkamem "hotel-booking-system/internal/kafka/memory"
+	"hotel-booking-system/internal/package/health"
+	"hotel-booking-system/package/api/openapi/bookingclient"
+)
+
+const calendarFeed = "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:ext-1\r\nDTSTART;VALUE=DATE:20300110\r\n" +
+	"DTEND;VALUE=DATE:20300112\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+func TestCalendarRequiresRole(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	repo.AddUser(repository.User{ID: 1, Email: "anna@example.com", FullName: "Анна Петрова"})
+	hotel := fakehotel.New()
+	hotel.AddRoomType(fakehotel.RoomType{HotelID: 1, ID: 1, Name: "Double", Price: 5000, RoomIDs: []int{101}})
+
+	server := NewBookingServer(stg.NewStorage(repo, hotel, kafkamem.NewBus(), stg.NoopPayments{}), health.NewChecker())
+	server.SetServer()
+	ts := httptest.NewServer(server.Mux)
+	defer ts.Close()
+	client, err := bookingclient.NewClientWithResponses(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := map[string]func(actor *string) (int, error){
+		"token": func(actor *string) (int, error) {
+			resp, err := client.CreateCalendarTokenWithResponse(ctx, &bookingclient.CreateCalendarTokenParams{XActor: actor},
+				bookingclient.CalendarTokenRequest{UserId: 1})
+			if err != nil {
+				return 0, err
+			}
+			return resp.StatusCode(), nil
+		},
+		"import": func(actor *string) (int, error) {
+			source := "channel"
+			resp, err := client.ImportRoomCalendarWithBodyWithResponse(ctx, &bookingclient.ImportRoomCalendarParams{
+				HotelId: 1, RoomTypeId: 1, RoomId: 101, Source: &source, XActor: actor,
+			}, "text/calendar", strings.NewReader(calendarFeed))
+			if err != nil {
+				return 0, err
+			}
+			return resp.StatusCode(), nil
+		},
+	}
+
+	actor := func(s string) *string { return &s }
+	tests := []struct {
+		call  string
+		actor *string
+		want  int
+	}{
+		{"token", nil, http.StatusForbidden},
+		{"token", actor("user:2"), http.StatusForbidden},
+		{"token", actor("admin:anna"), http.StatusForbidden},
+		{"token", actor("user:1"), http.StatusOK},
+		{"import", nil, http.StatusForbidden},
+		{"import", actor("user:1"), http.StatusForbidden},
+		{"import", actor("manager:2"), http.StatusForbidden},
+		{"import", actor("manager:1"), http.StatusOK},
+		{"import", actor("admin:anna"), http.StatusOK},
+	}
+	for _, tt := range tests {
+		got, err := calls[tt.call](tt.actor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			name := "no actor"
+			if tt.actor != nil {
+				name = *tt.actor
+			}
+			t.Errorf("%s as %s: status %d, want %d", tt.call, name, got, tt.want)
+		}
+	}
+}
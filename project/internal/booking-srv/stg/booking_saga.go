@@ -0,0 +1,271 @@
+package stg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/exceptions"
+	"hotel-booking-system/internal/booking-srv/repository"
+	"hotel-booking-system/internal/booking-srv/saga"
+	"hotel-booking-system/internal/package/apperr"
+	"hotel-booking-system/package/events"
+	hotelv1 "hotel-booking-system/package/proto/fast/stable"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	createBookingSaga = "create-booking"
+
+	// sagaStaleAfter must stay well above the longest step timeout, otherwise
+	// a live saga could be resumed by another replica.
+	sagaStaleAfter = 2 * time.Minute
+)
+
+// bookingSagaData is the state of a create-booking saga. It is persisted
+// after every step.
+type bookingSagaData struct {
+	Info         BookingInfo `json:"info"`
+	UserEmail    string      `json:"user_email"`
+	UserName     string      `json:"user_name"`
+	TotalPrice   float64     `json:"total_price"`
+	Currency     string      `json:"currency"`
+	Discount     float64     `json:"discount"`
+	HotelName    string      `json:"hotel_name"`
+	HotelAddress string      `json:"hotel_address"`
+	HotelPhone   string      `json:"hotel_phone"`
+	RoomTypeName string      `json:"room_type_name"`
+	BookingID    int         `json:"booking_id"`
+	PaymentID    string      `json:"payment_id"`
+}
+
+func (s *Storage) newBookingSaga() *saga.Orchestrator[bookingSagaData] {
+	return saga.NewOrchestrator(s.repo, saga.Definition[bookingSagaData]{
+		Type: createBookingSaga,
+		Steps: []saga.Step[bookingSagaData]{
+			{Name: "quote", Timeout: 5 * time.Second, Action: s.quoteStep},
+			{Name: "hold_room", Timeout: 10 * time.Second, Action: s.holdRoomStep, Compensate: s.releaseRoomStep},
+			{Name: "authorize_payment", Timeout: 10 * time.Second, Action: s.authorizePaymentStep, Compensate: s.voidPaymentStep},
+			{Name: "confirm", Timeout: 5 * time.Second, Action: s.confirmBookingStep},
+		},
+	}, sagaStaleAfter)
+}
+
+// ResumeSagas continues up to limit create-booking sagas abandoned by a
+// stopped process.
+func (s *Storage) ResumeSagas(ctx context.Context, limit int) (int, error) {
+	return s.bookingSaga.Resume(ctx, limit)
+}
+
+// quoteStep prices the stay and takes the hotel details snapshot.
+func (s *Storage) quoteStep(ctx context.Context, _ int64, d *bookingSagaData) error {
+	info := d.Info
+
+	priceResp, err := s.hotelClient.GetRoomPrice(ctx, &hotelv1.GetRoomPriceRequest{
+		HotelId:    int32(info.HotelID),
+		RoomTypeId: int32(info.RoomTypeID),
+	})
+	if err != nil {
+		logrus.Errorf("Failed to get room price: %v", err)
+		if apperr.FromGRPC(err) == apperr.NotFound {
+			return apperr.Invalid([]apperr.FieldError{{Field: "room_type_id", Message: "does not exist in this hotel"}})
+		}
+		return hotelError("failed to get room price", err)
+	}
+
+	days := int(info.CheckOutDate.Sub(info.CheckInDate).Hours() / 24)
+	totalPrice := priceResp.Price * float64(days)
+
+	discount := float64(info.RedeemPoints) * rublesPerPoint
+	if discount > totalPrice {
+		return fmt.Errorf("%w: cannot redeem more points than the booking costs", exceptions.ErrInvalidRequest)
+	}
+
+	details, err := s.hotelClient.GetHotelDetails(ctx, &hotelv1.GetHotelDetailsRequest{
+		HotelId:    int32(info.HotelID),
+		RoomTypeId: int32(info.RoomTypeID),
+	})
+	if err != nil {
+		logrus.Errorf("Failed to get hotel details: %v", err)
+		return hotelError("failed to get hotel details", err)
+	}
+
+	d.UserEmail, d.UserName = info.UserEmail, info.UserName
+	if d.UserEmail == "" {
+		if d.UserEmail, d.UserName, err = s.contactFor(ctx, info.UserID); err != nil {
+			return err
+		}
+	}
+
+	d.TotalPrice = totalPrice - discount
+	d.Currency = priceResp.Currency
+	d.Discount = discount
+	d.HotelName = details.HotelName
+	d.HotelAddress = details.Address
+	d.HotelPhone = details.ContactPhone
+	d.RoomTypeName = details.RoomTypeName
+	return nil
+}
+
+// holdRoomStep picks a free room and inserts the booking as pending, which
+// already blocks the room and debits redeemed loyalty points.
+func (s *Storage) holdRoomStep(ctx context.Context, sagaID int64, d *bookingSagaData) error {
+	bookingID, err := s.repo.GetBookingIDBySaga(ctx, sagaID)
+	if err == nil {
+		d.BookingID = bookingID
+		return nil
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+
+	info := d.Info
+	roomsResp, err := s.hotelClient.GetRoomsID(ctx, &hotelv1.GetRoomsIDRequest{
+		HotelId:    int32(info.HotelID),
+		RoomTypeId: int32(info.RoomTypeID),
+	})
+	if err != nil {
+		logrus.Errorf("Failed to get rooms list: %v", err)
+		return hotelError("failed to fetch rooms from hotel service", err)
+	}
+
+	if len(roomsResp.RoomIds) == 0 {
+		return fmt.Errorf("%w: no rooms found for this type in hotel", exceptions.ErrHotelNotFound)
+	}
+
+	busyRooms, err := s.repo.GetBusyRooms(ctx, info.CheckInDate, info.CheckOutDate)
+	if err != nil {
+		return fmt.Errorf("failed to check local availability: %w", err)
+	}
+
+	// busyRooms may already be stale: CreateBooking checks the room again
+	// under its lock, and a room taken in between sends us to the next one.
+	for _, roomID := range roomsResp.RoomIds {
+		if busyRooms[int(roomID)] {
+			continue
+		}
+		booking := &repository.Booking{
+			UserID:          info.UserID,
+			HotelID:         info.HotelID,
+			RoomID:          int(roomID),
+			CheckInDate:     info.CheckInDate,
+			CheckOutDate:    info.CheckOutDate,
+			GuestsCount:     info.GuestsCount,
+			TotalPrice:      d.TotalPrice,
+			LoyaltyDiscount: d.Discount,
+			Status:          repository.StatusPending,
+			HotelName:       d.HotelName,
+			HotelAddress:    d.HotelAddress,
+			HotelPhone:      d.HotelPhone,
+			RoomTypeName:    d.RoomTypeName,
+			SagaID:          sagaID,
+		}
+		bookingID, err = s.repo.CreateBooking(ctx, booking, info.RedeemPoints, nil)
+		if errors.Is(err, repository.ErrRoomNotAvailable) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create booking in db: %w", err)
+		}
+		d.BookingID = bookingID
+		return nil
+	}
+
+	roomsNotAvailable.WithLabelValues("create").Inc()
+	return exceptions.ErrRoomNotAvailable
+}
+
+// releaseRoomStep frees the held room. A booking that already got confirmed
+// is cancelled the regular way, so that the guest is told about it.
+func (s *Storage) releaseRoomStep(ctx context.Context, sagaID int64, d *bookingSagaData) error {
+	if d.BookingID == 0 {
+		bookingID, err := s.repo.GetBookingIDBySaga(ctx, sagaID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		d.BookingID = bookingID
+	}
+
+	released, err := s.repo.ReleaseHold(ctx, d.BookingID)
+	if err != nil || released {
+		return err
+	}
+
+	booking, err := s.repo.GetBooking(ctx, d.BookingID)
+	if err != nil {
+		return err
+	}
+	if booking.Status == repository.StatusConfirmed {
+		return s.CancelBooking(ctx, d.BookingID)
+	}
+	return nil
+}
+
+func (s *Storage) authorizePaymentStep(ctx context.Context, sagaID int64, d *bookingSagaData) error {
+	if d.TotalPrice <= 0 {
+		return nil
+	}
+	paymentID, err := s.payments.Authorize(ctx, paymentKey(sagaID), d.Info.UserID, d.TotalPrice)
+	if err != nil {
+		return fmt.Errorf("failed to authorize payment: %w", err)
+	}
+	d.PaymentID = paymentID
+	return nil
+}
+
+func (s *Storage) voidPaymentStep(ctx context.Context, sagaID int64, d *bookingSagaData) error {
+	if d.TotalPrice <= 0 {
+		return nil
+	}
+	if err := s.payments.Void(ctx, paymentKey(sagaID)); err != nil {
+		return fmt.Errorf("failed to void payment: %w", err)
+	}
+	return nil
+}
+
+// confirmBookingStep turns the hold into a confirmed booking and queues the
+// booking-created event in the same transaction.
+func (s *Storage) confirmBookingStep(ctx context.Context, _ int64, d *bookingSagaData) error {
+	newEvent := func(_, after *repository.Booking) (repository.OutboxMessage, error) {
+		return outboxMessage(ctx, after.ID, events.BookingCreatedEvent{
+			BookingID:    after.ID,
+			UserEmail:    d.UserEmail,
+			UserName:     d.UserName,
+			HotelName:    after.HotelName,
+			HotelAddress: after.HotelAddress,
+			HotelPhone:   after.HotelPhone,
+			RoomTypeName: after.RoomTypeName,
+			Amount:       after.TotalPrice,
+			CheckInDate:  after.CheckInDate.Format("2006-01-02"),
+			CheckOutDate: after.CheckOutDate.Format("2006-01-02"),
+		})
+	}
+
+	confirmed, err := s.repo.UpdateStatus(ctx, d.BookingID, repository.StatusPending, repository.StatusConfirmed, newEvent)
+	if err != nil {
+		return err
+	}
+	if confirmed {
+		bookingTransitions.WithLabelValues(repository.StatusConfirmed).Inc()
+		bookingRevenue.WithLabelValues(d.Currency).Add(d.TotalPrice)
+		return nil
+	}
+
+	booking, err := s.repo.GetBooking(ctx, d.BookingID)
+	if err != nil {
+		return err
+	}
+	if booking.Status != repository.StatusConfirmed {
+		return fmt.Errorf("%w: booking %d is %s and cannot be confirmed", exceptions.ErrBookingStatus, d.BookingID, booking.Status)
+	}
+	return nil
+}
+
+func paymentKey(sagaID int64) string {
+	return fmt.Sprintf("booking-saga-%d", sagaID)
+}
@@ -0,0 +1,260 @@
+package stg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/exceptions"
+	"hotel-booking-system/internal/booking-srv/hotelclient/fakehotel"
+	"hotel-booking-system/internal/booking-srv/repository"
+	"hotel-booking-system/internal/booking-srv/repository/memory"
+	"hotel-booking-system/internal/package/apperr"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func lastSaga(t *testing.T, f *fixture) repository.SagaRecord {
+	t.Helper()
+	sagas := f.repo.Sagas()
+	if len(sagas) == 0 {
+		t.Fatal("no saga was started")
+	}
+	return sagas[len(sagas)-1]
+}
+
+func TestCreateBookingSaga(t *testing.T) {
+	f := newFixture(t)
+	revenue := testutil.ToFloat64(bookingRevenue.WithLabelValues("RUB"))
+	b := f.create(t, bookingInfo(10, 12))
+
+	rec := lastSaga(t, f)
+	if rec.Status != repository.SagaCompleted || rec.Step != 4 {
+		t.Errorf("saga %s at step %d", rec.Status, rec.Step)
+	}
+	var data bookingSagaData
+	if err := json.Unmarshal(rec.Data, &data); err != nil {
+		t.Fatal(err)
+	}
+	if data.BookingID != b.ID || data.PaymentID != "pay-"+paymentKey(rec.ID) || data.Currency != "RUB" {
+		t.Errorf("saga data %+v", data)
+	}
+	if got := f.payments.authorized[paymentKey(rec.ID)]; got != b.TotalPrice {
+		t.Errorf("authorized %.2f, want %.2f", got, b.TotalPrice)
+	}
+	if got := testutil.ToFloat64(bookingRevenue.WithLabelValues("RUB")) - revenue; got != b.TotalPrice {
+		t.Errorf("revenue in RUB grew by %.2f, want %.2f", got, b.TotalPrice)
+	}
+
+	// A second booking for the same dates gets the other room.
+	if second := f.create(t, bookingInfo(11, 13)); second.RoomID != 102 {
+		t.Errorf("second booking in room %d, want 102", second.RoomID)
+	}
+}
+
+func TestCreateBookingRejected(t *testing.T) {
+	unknownType := bookingInfo(10, 12)
+	unknownType.RoomTypeID = 9
+	unknownUser := bookingInfo(10, 12)
+	unknownUser.UserID = 9
+	tooManyPoints := bookingInfo(10, 12)
+	tooManyPoints.RedeemPoints = 20000
+	negativePoints := bookingInfo(10, 12)
+	negativePoints.RedeemPoints = -1
+
+	for name, tc := range map[string]struct {
+		info BookingInfo
+		want apperr.Code
+	}{
+		"reversed dates":  {bookingInfo(12, 10), apperr.InvalidArgument},
+		"unknown user":    {unknownUser, apperr.InvalidArgument},
+		"unknown type":    {unknownType, apperr.InvalidArgument},
+		"points > price":  {tooManyPoints, apperr.InvalidArgument},
+		"negative points": {negativePoints, apperr.InvalidArgument},
+	} {
+		t.Run(name, func(t *testing.T) {
+			f := newFixture(t)
+			_, err := f.storage.CreateBooking(context.Background(), tc.info)
+			if got := apperr.CodeOf(err); got != tc.want {
+				t.Errorf("got %v (%s), want %s", err, got, tc.want)
+			}
+			if bookings, _ := f.repo.GetUserBookings(context.Background(), tc.info.UserID); len(bookings) != 0 {
+				t.Errorf("rejected booking was stored")
+			}
+		})
+	}
+}
+
+func TestCreateBookingHotelUnavailable(t *testing.T) {
+	f := newFixture(t)
+	f.hotel.Fail(fakehotel.GetRoomPrice, status.Error(codes.Unavailable, "connection refused"))
+
+	_, err := f.storage.CreateBooking(context.Background(), bookingInfo(10, 12))
+	if !errors.Is(err, exceptions.ErrHotelUnavailable) {
+		t.Fatalf("got %v", err)
+	}
+	if rec := lastSaga(t, f); rec.Status != repository.SagaCompensated || rec.Step != 0 {
+		t.Errorf("saga %s at step %d", rec.Status, rec.Step)
+	}
+}
+
+func TestCreateBookingNoFreeRoom(t *testing.T) {
+	f := newFixture(t)
+	f.addBooking(101, 9, 11, repository.StatusConfirmed, 0)
+	f.addBooking(102, 11, 14, repository.StatusCheckedIn, 0)
+	f.addBooking(102, 10, 12, repository.StatusCancelled, 0)
+
+	_, err := f.storage.CreateBooking(context.Background(), bookingInfo(10, 12))
+	if !errors.Is(err, exceptions.ErrRoomNotAvailable) {
+		t.Fatalf("got %v", err)
+	}
+	if rec := lastSaga(t, f); rec.Status != repository.SagaCompensated {
+		t.Errorf("saga %s", rec.Status)
+	}
+	if len(f.payments.authorized) != 0 {
+		t.Errorf("payment authorized without a room")
+	}
+
+	// Bookings that end on the check-in day do not overlap.
+	if b := f.create(t, bookingInfo(14, 15)); b.RoomID != 101 {
+		t.Errorf("room %d, want 101", b.RoomID)
+	}
+}
+
+// staleRepo reports every room as free, like a read that raced with another
+// booking.
+type staleRepo struct {
+	*memory.Repository
+}
+
+func (staleRepo) GetBusyRooms(ctx context.Context, checkIn, checkOut time.Time) (map[int]bool, error) {
+	return map[int]bool{}, nil
+}
+
+func TestCreateBookingStaleAvailability(t *testing.T) {
+	f := newFixture(t)
+	f.storage = NewStorage(staleRepo{f.repo}, f.hotel, f.bus, f.payments)
+
+	if b := f.create(t, bookingInfo(10, 12)); b.RoomID != 101 {
+		t.Errorf("first booking in room %d, want 101", b.RoomID)
+	}
+	if b := f.create(t, bookingInfo(11, 13)); b.RoomID != 102 {
+		t.Errorf("second booking in room %d, want 102", b.RoomID)
+	}
+	if _, err := f.storage.CreateBooking(context.Background(), bookingInfo(11, 12)); !errors.Is(err, exceptions.ErrRoomNotAvailable) {
+		t.Fatalf("got %v, want no free room", err)
+	}
+}
+
+func TestCreateBookingInsufficientPoints(t *testing.T) {
+	f := newFixture(t)
+	paid := f.addBooking(102, -30, -29, repository.StatusCheckedOut, 0)
+	f.repo.AddLedgerEntry(testUser, paid, repository.LedgerEarn, 300)
+
+	info := bookingInfo(10, 12)
+	info.RedeemPoints = 500
+	_, err := f.storage.CreateBooking(context.Background(), info)
+	if !errors.Is(err, exceptions.ErrInsufficientFunds) {
+		t.Fatalf("got %v", err)
+	}
+	if got := f.balance(t); got != 300 {
+		t.Errorf("balance %d, want 300", got)
+	}
+}
+
+func TestCreateBookingPaymentDeclined(t *testing.T) {
+	f := newFixture(t)
+	paid := f.addBooking(102, -30, -29, repository.StatusCheckedOut, 0)
+	f.repo.AddLedgerEntry(testUser, paid, repository.LedgerEarn, 1000)
+	f.payments.declined = errors.New("card declined")
+
+	info := bookingInfo(10, 12)
+	info.RedeemPoints = 1000
+	if _, err := f.storage.CreateBooking(context.Background(), info); err == nil {
+		t.Fatal("booking created with a declined payment")
+	}
+
+	rec := lastSaga(t, f)
+	if rec.Status != repository.SagaCompensated || rec.LastError == "" {
+		t.Errorf("saga %s, last error %q", rec.Status, rec.LastError)
+	}
+	if len(f.payments.voided) != 1 || f.payments.voided[0] != paymentKey(rec.ID) {
+		t.Errorf("voided %v", f.payments.voided)
+	}
+
+	bookingID, err := f.repo.GetBookingIDBySaga(context.Background(), rec.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.booking(t, bookingID).Status; got != repository.StatusCancelled {
+		t.Errorf("held booking is %s, want cancelled", got)
+	}
+	if got := f.balance(t); got != 1000 {
+		t.Errorf("balance %d, want the redeemed points back", got)
+	}
+	if published := f.relay(t); len(published) != 0 {
+		t.Errorf("released hold published %s", eventTypes(published))
+	}
+}
+
+func TestResumeSagas(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	stale := time.Now().Add(-time.Hour)
+
+	start := func(status string, step int, data bookingSagaData) int64 {
+		t.Helper()
+		payload, err := json.Marshal(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec := &repository.SagaRecord{Type: createBookingSaga, Status: status, Step: step, Data: payload, Actor: "test"}
+		f.repo.SetClock(func() time.Time { return stale })
+		defer f.repo.SetClock(time.Now)
+		if err := f.repo.CreateSaga(ctx, rec); err != nil {
+			t.Fatal(err)
+		}
+		return rec.ID
+	}
+
+	// Interrupted after the quote: resuming books the room.
+	quoted := bookingSagaData{Info: bookingInfo(10, 12), TotalPrice: 2 * testPrice, HotelName: "Grand", RoomTypeName: "Double"}
+	running := start(repository.SagaRunning, 1, quoted)
+
+	// Interrupted while undoing a held room: resuming releases it.
+	compensating := start(repository.SagaCompensating, 2, bookingSagaData{Info: bookingInfo(20, 22), TotalPrice: 2 * testPrice})
+	held := f.repo.AddBooking(repository.Booking{
+		UserID: testUser, HotelID: testHotel, RoomID: 101, CheckInDate: day(20), CheckOutDate: day(22),
+		Status: repository.StatusPending, SagaID: compensating,
+	})
+
+	resumed, err := f.storage.ResumeSagas(ctx, 10)
+	if err != nil || resumed != 2 {
+		t.Fatalf("resumed %d, %v", resumed, err)
+	}
+
+	bookingID, err := f.repo.GetBookingIDBySaga(ctx, running)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := f.booking(t, bookingID); b.Status != repository.StatusConfirmed || b.HotelName != "Grand" {
+		t.Errorf("resumed booking %+v", b)
+	}
+	if got := f.booking(t, held).Status; got != repository.StatusCancelled {
+		t.Errorf("held booking is %s, want cancelled", got)
+	}
+	for _, rec := range f.repo.Sagas() {
+		if rec.Status != repository.SagaCompleted && rec.Status != repository.SagaCompensated {
+			t.Errorf("saga %d left %s", rec.ID, rec.Status)
+		}
+	}
+
+	// Finished sagas are not picked up again.
+	if resumed, _ := f.storage.ResumeSagas(ctx, 10); resumed != 0 {
+		t.Errorf("resumed %d finished sagas", resumed)
+	}
+}
@@ -0,0 +1,143 @@
+package stg
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"slices"
+
+	"hotel-booking-system/internal/booking-srv/exceptions"
+	"hotel-booking-system/internal/booking-srv/repository"
+	"hotel-booking-system/internal/package/ical"
+	hotelv1 "hotel-booking-system/package/proto/fast/stable"
+)
+
+const calendarUIDDomain = "@hotel-booking-system"
+
+// CreateCalendarToken issues a new secret token for the user's booking feed.
+// Issuing a token revokes the previous one.
+func (s *Storage) CreateCalendarToken(ctx context.Context, userID int) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate calendar token: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+
+	if err := s.repo.SaveCalendarToken(ctx, userID, token); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (s *Storage) GetUserCalendar(ctx context.Context, token string) ([]ical.Event, error) {
+	userID, err := s.repo.GetUserIDByCalendarToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	bookings, err := s.repo.GetUserBookings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := make([]ical.Event, 0, len(bookings))
+	for _, b := range bookings {
+		calendar = append(calendar, ical.Event{
+			UID:     fmt.Sprintf("booking-%d%s", b.ID, calendarUIDDomain),
+			Summary: fmt.Sprintf("Hotel booking #%d", b.ID),
+			Description: fmt.Sprintf("Hotel %d, room %d, %d guests, total %.2f",
+				b.HotelID, b.RoomID, b.GuestsCount, b.TotalPrice),
+			Start:     b.CheckInDate,
+			End:       b.CheckOutDate,
+			AllDay:    true,
+			Cancelled: b.Status == repository.StatusCancelled || b.Status == repository.StatusNoShow,
+		})
+	}
+	return calendar, nil
+}
+
+// GetRoomCalendar lists the ranges in which the room is busy, both our own
+// bookings and blocks imported from external channels. Guest details are
+// left out on purpose.
+func (s *Storage) GetRoomCalendar(ctx context.Context, roomID int) ([]ical.Event, error) {
+	bookings, err := s.repo.GetRoomBookings(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := s.repo.GetRoomBlocks(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := make([]ical.Event, 0, len(bookings)+len(blocks))
+	for _, b := range bookings {
+		calendar = append(calendar, ical.Event{
+			UID:     fmt.Sprintf("booking-%d%s", b.ID, calendarUIDDomain),
+			Summary: "Busy",
+			Start:   b.CheckInDate,
+			End:     b.CheckOutDate,
+			AllDay:  true,
+		})
+	}
+	for _, b := range blocks {
+		calendar = append(calendar, ical.Event{
+			UID:     fmt.Sprintf("block-%d%s", b.ID, calendarUIDDomain),
+			Summary: "Busy",
+			Start:   b.StartsAt,
+			End:     b.EndsAt,
+		})
+	}
+	return calendar, nil
+}
+
+// ImportRoomCalendar syncs the room's blocks from an external channel feed.
+// Cancelled and empty events are skipped.
+func (s *Storage) ImportRoomCalendar(ctx context.Context, hotelID, roomTypeID, roomID int, source string, r io.Reader) (int, error) {
+	if source == "" {
+		return 0, fmt.Errorf("%w: calendar source is required", exceptions.ErrInvalidRequest)
+	}
+
+	// The caller's role is checked against hotelID, so the room must be one
+	// of that hotel's.
+	roomsResp, err := s.hotelClient.GetRoomsID(ctx, &hotelv1.GetRoomsIDRequest{
+		HotelId:    int32(hotelID),
+		RoomTypeId: int32(roomTypeID),
+	})
+	if err != nil {
+		return 0, hotelError("failed to fetch rooms from hotel service", err)
+	}
+	if !slices.Contains(roomsResp.RoomIds, int32(roomID)) {
+		return 0, fmt.Errorf("%w: room %d is not in room type %d of hotel %d", exceptions.ErrNotFound, roomID, roomTypeID, hotelID)
+	}
+
+	calendar, err := ical.Parse(r)
+	if err != nil {
+		return 0, fmt.Errorf("%w: failed to parse calendar: %w", exceptions.ErrInvalidRequest, err)
+	}
+
+	var blocks []repository.RoomBlock
+	for _, e := range calendar {
+		if e.Cancelled || !e.End.After(e.Start) {
+			continue
+		}
+		uid := e.UID
+		if uid == "" {
+			uid = fmt.Sprintf("%d-%d", e.Start.Unix(), e.End.Unix())
+		}
+		blocks = append(blocks, repository.RoomBlock{
+			RoomID:      roomID,
+			StartsAt:    e.Start,
+			EndsAt:      e.End,
+			Source:      source,
+			ExternalUID: uid,
+			Summary:     e.Summary,
+		})
+	}
+
+	if err := s.repo.ReplaceRoomBlocks(ctx, roomID, source, blocks); err != nil {
+		return 0, err
+	}
+	return len(blocks), nil
+}
@@ -0,0 +1,29 @@
+package stg
+
+import (
+	"fmt"
+
+	"hotel-booking-system/internal/booking-srv/exceptions"
+	"hotel-booking-system/internal/package/apperr"
+
+	"google.golang.org/grpc/status"
+)
+
+// hotelError translates an error of a hotel-srv call into the domain error
+// matching its gRPC code and keeps the hotel's message as the detail.
+func hotelError(op string, err error) error {
+	var domainErr error
+	switch apperr.FromGRPC(err) {
+	case apperr.NotFound:
+		domainErr = exceptions.ErrHotelNotFound
+	case apperr.InvalidArgument:
+		domainErr = exceptions.ErrHotelRejected
+	case apperr.AlreadyExists, apperr.Conflict, apperr.FailedPrecondition:
+		domainErr = exceptions.ErrHotelConflict
+	case apperr.Unavailable, apperr.DeadlineExceeded:
+		domainErr = exceptions.ErrHotelUnavailable
+	default:
+		domainErr = exceptions.ErrProblemsWithHotelManager
+	}
+	return fmt.Errorf("%s: %w: %s", op, domainErr, status.Convert(err).Message())
+}
@@ -0,0 +1,91 @@
+package stg
+
+import (
+	"context"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/repository"
+)
+
+const (
+	// One point is earned for every 100 RUB paid and is worth 1 RUB when
+	// redeemed.
+	pointsPerRuble = 0.01
+	rublesPerPoint = 1.0
+
+	silverNights = 10
+	goldNights   = 30
+)
+
+const (
+	TierBasic  = "basic"
+	TierSilver = "silver"
+	TierGold   = "gold"
+)
+
+type LoyaltyStatus struct {
+	UserID         int    `json:"user_id"`
+	Balance        int    `json:"balance"`
+	Tier           string `json:"tier"`
+	NightsThisYear int    `json:"nights_this_year"`
+}
+
+func (s *Storage) GetLoyaltyStatus(ctx context.Context, userID int) (*LoyaltyStatus, error) {
+	balance, err := s.repo.GetLoyaltyBalance(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := yearBounds(time.Now())
+	nights, err := s.repo.GetStayNights(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoyaltyStatus{
+		UserID:         userID,
+		Balance:        balance,
+		Tier:           tierForNights(nights),
+		NightsThisYear: nights,
+	}, nil
+}
+
+// pointsForStay counts the stay being checked out towards the tier, so the
+// stay that reaches a threshold already earns at the higher rate.
+func (s *Storage) pointsForStay(ctx context.Context, booking *repository.Booking, now time.Time) (int, error) {
+	from, to := yearBounds(now)
+	nights, err := s.repo.GetStayNights(ctx, booking.UserID, from, to)
+	if err != nil {
+		return 0, err
+	}
+	nights += int(booking.CheckOutDate.Sub(booking.CheckInDate).Hours() / 24)
+
+	return int(booking.TotalPrice * pointsPerRuble * tierMultiplier(tierForNights(nights))), nil
+}
+
+func tierForNights(nights int) string {
+	switch {
+	case nights >= goldNights:
+		return TierGold
+	case nights >= silverNights:
+		return TierSilver
+	default:
+		return TierBasic
+	}
+}
+
+func tierMultiplier(tier string) float64 {
+	switch tier {
+	case TierGold:
+		return 1.5
+	case TierSilver:
+		return 1.25
+	default:
+		return 1
+	}
+}
+
+func yearBounds(now time.Time) (time.Time, time.Time) {
+	from := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location())
+	return from, from.AddDate(1, 0, 0)
+}
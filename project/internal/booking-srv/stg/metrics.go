@@ -0,0 +1,23 @@
+package stg
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	bookingTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bookings_total",
+		Help: "Bookings that entered a status, by status.",
+	}, []string{"status"})
+
+	bookingRevenue = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "booking_revenue_total",
+		Help: "Total price of confirmed bookings after loyalty discounts, by the currency of the room price.",
+	}, []string{"currency"})
+
+	roomsNotAvailable = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "booking_rooms_not_available_total",
+		Help: "Requests rejected because no room was free for the dates, by operation.",
+	}, []string{"operation"})
+)
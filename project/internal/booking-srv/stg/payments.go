@@ -0,0 +1,29 @@
+package stg
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// PaymentGateway authorizes and voids booking payments. Both calls are keyed
+// by an idempotency key, so a saga step repeated after a restart neither
+// charges twice nor needs the payment ID to undo an authorization.
+type PaymentGateway interface {
+	Authorize(ctx context.Context, idempotencyKey string, userID int, amount float64) (string, error)
+	Void(ctx context.Context, idempotencyKey string) error
+}
+
+// NoopPayments approves every payment. It stands in for the payment service
+// until one is connected.
+type NoopPayments struct{}
+
+func (NoopPayments) Authorize(ctx context.Context, idempotencyKey string, userID int, amount float64) (string, error) {
+	logrus.Infof("Payment %s of %.2f for user %d approved without a payment service", idempotencyKey, amount, userID)
+	return idempotencyKey, nil
+}
+
+func (NoopPayments) Void(ctx context.Context, idempotencyKey string) error {
+	logrus.Infof("Payment %s voided without a payment service", idempotencyKey)
+	return nil
+}
@@ -3,17 +3,27 @@ package stg
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
+	"hotel-booking-system/internal/booking-srv/exceptions"
 	"hotel-booking-system/internal/booking-srv/repository"
-	"hotel-booking-system/internal/kafka"
+	"hotel-booking-system/internal/booking-srv/saga"
+	"hotel-booking-system/internal/package/apperr"
+	"hotel-booking-system/internal/package/requestctx"
 	"hotel-booking-system/package/events"
 	hotelv1 "hotel-booking-system/package/proto/fast/stable"
 
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 )
 
+// producerName identifies booking-srv in event envelopes.
+const producerName = "booking-srv"
+
 type BookingInfo struct {
 	UserID       int       `json:"user_id"`
 	HotelID      int       `json:"hotel_id"`
@@ -23,109 +33,372 @@ type BookingInfo struct {
 	GuestsCount  int       `json:"guests_count"`
 	UserEmail    string    `json:"user_email"`
 	UserName     string    `json:"user_name"`
+	RedeemPoints int       `json:"redeem_points"`
+}
+
+// ModifyInfo holds the new terms of an existing booking.
+type ModifyInfo struct {
+	CheckInDate  time.Time `json:"check_in_date"`
+	CheckOutDate time.Time `json:"check_out_date"`
+	GuestsCount  int       `json:"guests_count"`
+}
+
+type ReviewInfo struct {
+	UserID    int    `json:"user_id"`
+	BookingID int    `json:"booking_id"`
+	Rating    int    `json:"rating"`
+	Text      string `json:"text"`
+}
+
+// Repository is the booking persistence Storage works with.
+// *repository.Repository implements it on Postgres.
+type Repository interface {
+	saga.Store
+
+	GetUser(ctx context.Context, userID int) (*repository.User, error)
+	GetBooking(ctx context.Context, bookingID int) (*repository.Booking, error)
+	GetBookingIDBySaga(ctx context.Context, sagaID int64) (int, error)
+	GetUserBookings(ctx context.Context, userID int) ([]repository.Booking, error)
+	GetHotelBookings(ctx context.Context, hotelID int) ([]repository.Booking, error)
+	GetRoomBookings(ctx context.Context, roomID int) ([]repository.Booking, error)
+	GetBusyRooms(ctx context.Context, checkIn, checkOut time.Time) (map[int]bool, error)
+	GetBookingEvents(ctx context.Context, bookingID int) ([]repository.BookingEvent, error)
+
+	CreateBooking(ctx context.Context, booking *repository.Booking, redeemPoints int, newEvent repository.ChangeEvent) (int, error)
+	UpdateStatus(ctx context.Context, bookingID int, from, to string, newEvent repository.ChangeEvent) (bool, error)
+	CheckOut(ctx context.Context, bookingID, userID, points int, newEvent repository.ChangeEvent) (bool, error)
+	CancelBooking(ctx context.Context, bookingID int, newEvent repository.ChangeEvent) (bool, error)
+	ReleaseHold(ctx context.Context, bookingID int) (bool, error)
+	ModifyBooking(ctx context.Context, changed *repository.Booking, newEvent repository.ChangeEvent) (bool, error)
+	GetNoShowCandidates(ctx context.Context, now time.Time) ([]repository.NoShowCandidate, error)
+	MarkNoShow(ctx context.Context, bookingID int, penalty float64, newEvent repository.ChangeEvent) (bool, error)
+
+	ProcessOutbox(ctx context.Context, limit int, publish func(context.Context, repository.OutboxMessage) error) (int, error)
+
+	GetLoyaltyBalance(ctx context.Context, userID int) (int, error)
+	GetStayNights(ctx context.Context, userID int, from, to time.Time) (int, error)
+
+	SaveCalendarToken(ctx context.Context, userID int, token string) error
+	GetUserIDByCalendarToken(ctx context.Context, token string) (int, error)
+	GetRoomBlocks(ctx context.Context, roomID int) ([]repository.RoomBlock, error)
+	ReplaceRoomBlocks(ctx context.Context, roomID int, source string, blocks []repository.RoomBlock) error
+}
+
+// Publisher delivers events to the message broker; *kafka.Producer
+// implements it.
+type Publisher interface {
+	ProduceEnvelope(ctx context.Context, env *events.Envelope, topic, key string) error
 }
 
 type Storage struct {
-	repo        *repository.Repository
+	repo        Repository
 	hotelClient hotelv1.HotelServiceClient
-	producer    *kafka.Producer
+	producer    Publisher
+	payments    PaymentGateway
+	bookingSaga *saga.Orchestrator[bookingSagaData]
 }
 
-func NewStorage(repo *repository.Repository, client hotelv1.HotelServiceClient, producer *kafka.Producer) *Storage {
-	return &Storage{
+func NewStorage(repo Repository, client hotelv1.HotelServiceClient, producer Publisher, payments PaymentGateway) *Storage {
+	s := &Storage{
 		repo:        repo,
 		hotelClient: client,
 		producer:    producer,
+		payments:    payments,
 	}
+	s.bookingSaga = s.newBookingSaga()
+	return s
 }
 
+// CreateBooking runs the create-booking saga: it prices the stay, holds a
+// room, authorizes the payment and confirms the booking, undoing the earlier
+// steps when a later one fails.
 func (s *Storage) CreateBooking(ctx context.Context, info BookingInfo) (int, error) {
-	priceReq := &hotelv1.GetRoomPriceRequest{
-		HotelId:    int32(info.HotelID),
-		RoomTypeId: int32(info.RoomTypeID),
+	days := int(info.CheckOutDate.Sub(info.CheckInDate).Hours() / 24)
+	if days <= 0 {
+		return 0, fmt.Errorf("%w: check-out must be after check-in", exceptions.ErrDates)
+	}
+	if info.RedeemPoints < 0 {
+		return 0, fmt.Errorf("%w: redeemed points must not be negative", exceptions.ErrInvalidRequest)
+	}
+	if _, err := s.repo.GetUser(ctx, info.UserID); errors.Is(err, repository.ErrNotFound) {
+		return 0, apperr.Invalid([]apperr.FieldError{{Field: "user_id", Message: "does not exist"}})
+	} else if err != nil {
+		return 0, err
 	}
 
-	priceResp, err := s.hotelClient.GetRoomPrice(ctx, priceReq)
+	result, err := s.bookingSaga.Run(ctx, bookingSagaData{Info: info})
 	if err != nil {
-		logrus.Errorf("Failed to get room price: %v", err)
-		return 0, fmt.Errorf("failed to get room price: %w", err)
+		return 0, err
 	}
+	return result.BookingID, nil
+}
 
-	days := int(info.CheckOutDate.Sub(info.CheckInDate).Hours() / 24)
-	if days <= 0 {
-		return 0, fmt.Errorf("invalid dates: check-out must be after check-in")
+func (s *Storage) CheckIn(ctx context.Context, bookingID int) error {
+	booking, err := s.getBookingInStatus(ctx, bookingID, repository.StatusConfirmed)
+	if err != nil {
+		return err
+	}
+
+	email, name, err := s.contactFor(ctx, booking.UserID)
+	if err != nil {
+		return err
 	}
-	totalPrice := priceResp.Price * float64(days)
 
-	roomsReq := &hotelv1.GetRoomsIDRequest{
-		HotelId:    int32(info.HotelID),
-		RoomTypeId: int32(info.RoomTypeID),
+	newEvent := func(_, after *repository.Booking) (repository.OutboxMessage, error) {
+		return outboxMessage(ctx, after.ID, events.BookingCheckedInEvent{
+			BookingID:    after.ID,
+			UserID:       after.UserID,
+			UserEmail:    email,
+			UserName:     name,
+			HotelID:      after.HotelID,
+			RoomID:       after.RoomID,
+			CheckInDate:  after.CheckInDate.Format("2006-01-02"),
+			CheckOutDate: after.CheckOutDate.Format("2006-01-02"),
+		})
 	}
 
-	roomsResp, err := s.hotelClient.GetRoomsID(ctx, roomsReq)
+	updated, err := s.repo.UpdateStatus(ctx, booking.ID, repository.StatusConfirmed, repository.StatusCheckedIn, newEvent)
 	if err != nil {
-		logrus.Errorf("Failed to get rooms list: %v", err)
-		return 0, fmt.Errorf("failed to fetch rooms from hotel service: %w", err)
+		return err
 	}
+	if !updated {
+		return fmt.Errorf("%w: booking %d", exceptions.ErrConcurrentChange, bookingID)
+	}
+	bookingTransitions.WithLabelValues(repository.StatusCheckedIn).Inc()
+
+	return nil
+}
 
-	if len(roomsResp.RoomIds) == 0 {
-		return 0, fmt.Errorf("no rooms found for this type in hotel")
+// CheckOut completes the stay and credits loyalty points for it.
+func (s *Storage) CheckOut(ctx context.Context, bookingID int) error {
+	booking, err := s.getBookingInStatus(ctx, bookingID, repository.StatusCheckedIn)
+	if err != nil {
+		return err
 	}
 
-	busyRooms, err := s.repo.GetBusyRooms(ctx, info.CheckInDate, info.CheckOutDate)
+	points, err := s.pointsForStay(ctx, booking, time.Now())
 	if err != nil {
-		return 0, fmt.Errorf("failed to check local availability: %w", err)
+		return err
 	}
 
-	var availableRoomID int
+	email, name, err := s.contactFor(ctx, booking.UserID)
+	if err != nil {
+		return err
+	}
 
-	for _, roomID := range roomsResp.RoomIds {
-		if !busyRooms[int(roomID)] {
-			availableRoomID = int(roomID)
-			break
-		}
+	newEvent := func(_, after *repository.Booking) (repository.OutboxMessage, error) {
+		return outboxMessage(ctx, after.ID, events.BookingCheckedOutEvent{
+			BookingID:    after.ID,
+			UserID:       after.UserID,
+			UserEmail:    email,
+			UserName:     name,
+			HotelID:      after.HotelID,
+			RoomID:       after.RoomID,
+			CheckInDate:  after.CheckInDate.Format("2006-01-02"),
+			CheckOutDate: after.CheckOutDate.Format("2006-01-02"),
+			TotalPrice:   after.TotalPrice,
+			PointsEarned: points,
+		})
 	}
 
-	if availableRoomID == 0 {
-		return 0, fmt.Errorf("no available rooms for selected dates")
+	updated, err := s.repo.CheckOut(ctx, booking.ID, booking.UserID, points, newEvent)
+	if err != nil {
+		return err
 	}
+	if !updated {
+		return fmt.Errorf("%w: booking %d", exceptions.ErrConcurrentChange, bookingID)
+	}
+	bookingTransitions.WithLabelValues(repository.StatusCheckedOut).Inc()
+
+	return nil
+}
 
-	booking := &repository.Booking{
-		UserID:       info.UserID,
-		HotelID:      info.HotelID,
-		RoomID:       availableRoomID,
-		CheckInDate:  info.CheckInDate,
-		CheckOutDate: info.CheckOutDate,
-		GuestsCount:  info.GuestsCount,
-		TotalPrice:   totalPrice,
+// CancelBooking cancels the booking and reverses any loyalty points it has
+// earned or spent.
+func (s *Storage) CancelBooking(ctx context.Context, bookingID int) error {
+	booking, err := s.repo.GetBooking(ctx, bookingID)
+	if err != nil {
+		return err
 	}
 
-	bookingID, err := s.repo.CreateBooking(ctx, booking)
+	email, name, err := s.contactFor(ctx, booking.UserID)
 	if err != nil {
-		return 0, fmt.Errorf("failed to create booking in db: %w", err)
+		return err
 	}
 
-	event := events.BookingCreatedEvent{
-		BookingID:    bookingID,
-		UserEmail:    info.UserEmail,
-		UserName:     info.UserName,
-		Amount:       totalPrice,
-		CheckInDate:  info.CheckInDate.Format("2006-01-02"),
-		CheckOutDate: info.CheckOutDate.Format("2006-01-02"),
+	newEvent := func(before, after *repository.Booking) (repository.OutboxMessage, error) {
+		return outboxMessage(ctx, after.ID, events.BookingCancelledEvent{
+			BookingID:      after.ID,
+			UserID:         after.UserID,
+			UserEmail:      email,
+			UserName:       name,
+			HotelID:        after.HotelID,
+			RoomID:         after.RoomID,
+			CheckInDate:    after.CheckInDate.Format("2006-01-02"),
+			CheckOutDate:   after.CheckOutDate.Format("2006-01-02"),
+			TotalPrice:     after.TotalPrice,
+			PreviousStatus: before.Status,
+		})
 	}
 
-	payload, err := json.Marshal(event)
-	if err == nil {
-		if err := s.producer.Produce(string(payload), "booking-created"); err != nil {
-			logrus.Errorf("Failed to send kafka event: %v", err)
-		} else {
-			logrus.Infof("Event sent to Kafka: %s", string(payload))
-		}
-	} else {
-		logrus.Errorf("Failed to marshal kafka event: %v", err)
+	cancelled, err := s.repo.CancelBooking(ctx, bookingID, newEvent)
+	if err != nil {
+		return err
+	}
+	if cancelled {
+		bookingTransitions.WithLabelValues(repository.StatusCancelled).Inc()
+		return nil
+	}
+
+	booking, err = s.repo.GetBooking(ctx, bookingID)
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: booking %d is %s and cannot be cancelled", exceptions.ErrBookingStatus, bookingID, booking.Status)
+}
+
+// ModifyBooking moves a confirmed booking to new dates or guest count in the
+// same room. The price is recalculated at the nightly rate the guest
+// originally paid; redeemed loyalty points keep their value.
+func (s *Storage) ModifyBooking(ctx context.Context, bookingID int, info ModifyInfo) error {
+	booking, err := s.getBookingInStatus(ctx, bookingID, repository.StatusConfirmed)
+	if err != nil {
+		return err
+	}
+
+	oldNights := int(booking.CheckOutDate.Sub(booking.CheckInDate).Hours() / 24)
+	newNights := int(info.CheckOutDate.Sub(info.CheckInDate).Hours() / 24)
+	if newNights <= 0 {
+		return fmt.Errorf("%w: check-out must be after check-in", exceptions.ErrDates)
+	}
+	if oldNights <= 0 {
+		return fmt.Errorf("booking %d has invalid stored dates", bookingID)
+	}
+	if info.GuestsCount <= 0 {
+		return fmt.Errorf("%w: guests count must be positive", exceptions.ErrInvalidRequest)
+	}
+
+	nightly := (booking.TotalPrice + booking.LoyaltyDiscount) / float64(oldNights)
+	totalPrice := nightly*float64(newNights) - booking.LoyaltyDiscount
+	if totalPrice < 0 {
+		totalPrice = 0
+	}
+
+	email, name, err := s.contactFor(ctx, booking.UserID)
+	if err != nil {
+		return err
+	}
+
+	newEvent := func(before, after *repository.Booking) (repository.OutboxMessage, error) {
+		return outboxMessage(ctx, after.ID, events.BookingModifiedEvent{
+			BookingID: after.ID,
+			UserID:    after.UserID,
+			UserEmail: email,
+			UserName:  name,
+			HotelID:   after.HotelID,
+			RoomID:    after.RoomID,
+			Before:    bookingTerms(before),
+			After:     bookingTerms(after),
+		})
+	}
+
+	changed := *booking
+	changed.CheckInDate = info.CheckInDate
+	changed.CheckOutDate = info.CheckOutDate
+	changed.GuestsCount = info.GuestsCount
+	changed.TotalPrice = totalPrice
+
+	modified, err := s.repo.ModifyBooking(ctx, &changed, newEvent)
+	if errors.Is(err, repository.ErrRoomNotAvailable) {
+		roomsNotAvailable.WithLabelValues("modify").Inc()
+	}
+	if err != nil {
+		return err
+	}
+	if !modified {
+		return fmt.Errorf("%w: booking %d", exceptions.ErrConcurrentChange, bookingID)
+	}
+
+	return nil
+}
+
+func bookingTerms(b *repository.Booking) events.BookingTerms {
+	return events.BookingTerms{
+		CheckInDate:  b.CheckInDate.Format("2006-01-02"),
+		CheckOutDate: b.CheckOutDate.Format("2006-01-02"),
+		GuestsCount:  b.GuestsCount,
+		TotalPrice:   b.TotalPrice,
+	}
+}
+
+// contactFor returns the e-mail and name notifications for the user are sent
+// to. Unknown users get empty values so the event is still published.
+func (s *Storage) contactFor(ctx context.Context, userID int) (string, string, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		logrus.Warnf("User %d not found, event will have no contact details", userID)
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	return user.Email, user.FullName, nil
+}
+
+func (s *Storage) getBookingInStatus(ctx context.Context, bookingID int, status string) (*repository.Booking, error) {
+	booking, err := s.repo.GetBooking(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.Status != status {
+		return nil, fmt.Errorf("%w: booking %d is %s, expected %s", exceptions.ErrBookingStatus, bookingID, booking.Status, status)
+	}
+	return booking, nil
+}
+
+// CreateReview checks that the booking belongs to the user and the stay is
+// over before handing the review to the hotel service.
+func (s *Storage) CreateReview(ctx context.Context, info ReviewInfo) (int, error) {
+	booking, err := s.repo.GetBooking(ctx, info.BookingID)
+	if err != nil {
+		return 0, err
+	}
+	if booking.UserID != info.UserID {
+		return 0, fmt.Errorf("%w: booking %d does not belong to user %d", exceptions.ErrNotBookingOwner, info.BookingID, info.UserID)
+	}
+	if booking.Status != repository.StatusCheckedOut {
+		return 0, fmt.Errorf("%w: reviews can only be left after checkout", exceptions.ErrBookingStatus)
+	}
+	if info.Rating < 1 || info.Rating > 5 {
+		return 0, fmt.Errorf("%w: rating must be between 1 and 5", exceptions.ErrInvalidRequest)
+	}
+
+	resp, err := s.hotelClient.CreateReview(ctx, &hotelv1.CreateReviewRequest{
+		HotelId:   int32(booking.HotelID),
+		BookingId: int32(booking.ID),
+		UserId:    int32(booking.UserID),
+		Rating:    int32(info.Rating),
+		Text:      info.Text,
+	})
+	if err != nil {
+		logrus.Errorf("Failed to create review: %v", err)
+		return 0, hotelError("failed to create review in hotel service", err)
 	}
 
-	return bookingID, nil
+	return int(resp.ReviewId), nil
+}
+
+// GetBookingHistory returns every recorded change of the booking, oldest
+// first.
+func (s *Storage) GetBookingHistory(ctx context.Context, bookingID int) ([]repository.BookingEvent, error) {
+	if _, err := s.repo.GetBooking(ctx, bookingID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetBookingEvents(ctx, bookingID)
+}
+
+func (s *Storage) GetBooking(ctx context.Context, bookingID int) (*repository.Booking, error) {
+	return s.repo.GetBooking(ctx, bookingID)
 }
 
 func (s *Storage) GetAllClientBookings(ctx context.Context, userID int) ([]repository.Booking, error) {
@@ -135,3 +408,103 @@ func (s *Storage) GetAllClientBookings(ctx context.Context, userID int) ([]repos
 func (s *Storage) GetAllHotelBookings(ctx context.Context, hotelID int) ([]repository.Booking, error) {
 	return s.repo.GetHotelBookings(ctx, hotelID)
 }
+
+// ProcessNoShows marks confirmed bookings whose check-in cutoff has passed as
+// no-show, charges the hotel's no-show penalty and releases the room.
+func (s *Storage) ProcessNoShows(ctx context.Context, now time.Time) (int, error) {
+	candidates, err := s.repo.GetNoShowCandidates(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, c := range candidates {
+		penalty := noShowPenalty(c.Booking, c.PenaltyNights)
+
+		email, name, err := s.contactFor(ctx, c.UserID)
+		if err != nil {
+			return processed, err
+		}
+
+		newEvent := func(_, after *repository.Booking) (repository.OutboxMessage, error) {
+			return outboxMessage(ctx, after.ID, events.BookingNoShowEvent{
+				BookingID:    after.ID,
+				UserID:       after.UserID,
+				HotelID:      after.HotelID,
+				RoomID:       after.RoomID,
+				CheckInDate:  after.CheckInDate.Format("2006-01-02"),
+				CheckOutDate: after.CheckOutDate.Format("2006-01-02"),
+				Penalty:      penalty,
+				UserEmail:    email,
+				UserName:     name,
+			})
+		}
+
+		marked, err := s.repo.MarkNoShow(ctx, c.ID, penalty, newEvent)
+		if err != nil {
+			return processed, err
+		}
+		if marked {
+			processed++
+			bookingTransitions.WithLabelValues(repository.StatusNoShow).Inc()
+		}
+	}
+
+	return processed, nil
+}
+
+// RelayOutbox publishes up to batchSize pending outbox messages to Kafka and
+// returns how many were sent.
+func (s *Storage) RelayOutbox(ctx context.Context, batchSize int) (int, error) {
+	return s.repo.ProcessOutbox(ctx, batchSize, func(ctx context.Context, m repository.OutboxMessage) error {
+		env, err := events.JSONCodec{}.Unmarshal(m.Payload)
+		if err != nil {
+			return fmt.Errorf("failed to decode outbox message %d: %w", m.ID, err)
+		}
+		// The span context saved with the event makes the publish part of
+		// the trace of the request that caused it.
+		msgCtx := otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(env.TraceContext))
+		if err := s.producer.ProduceEnvelope(msgCtx, env, m.Topic, m.Key); err != nil {
+			logrus.Errorf("Failed to send kafka event %d (attempt %d): %v", m.ID, m.Attempts+1, err)
+			return err
+		}
+		logrus.Infof("Event sent to Kafka: %s", string(m.Payload))
+		return nil
+	})
+}
+
+// outboxMessage wraps event in the common envelope and publishes it on the
+// topic named after its type, keyed by booking ID so that all events of one
+// booking land in the same partition in order.
+func outboxMessage(ctx context.Context, bookingID int, event events.Event) (repository.OutboxMessage, error) {
+	traceContext := map[string]string{}
+	if requestID := requestctx.RequestID(ctx); requestID != "" {
+		traceContext["x-request-id"] = requestID
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(traceContext))
+
+	env, err := events.NewEnvelope(producerName, event, traceContext)
+	if err != nil {
+		return repository.OutboxMessage{}, err
+	}
+	payload, err := json.Marshal(env)
+	if err != nil {
+		return repository.OutboxMessage{}, fmt.Errorf("failed to marshal kafka event: %w", err)
+	}
+	return repository.OutboxMessage{
+		Topic:   event.EventType(),
+		Key:     strconv.Itoa(bookingID),
+		Payload: payload,
+	}, nil
+}
+
+func noShowPenalty(b repository.Booking, penaltyNights int) float64 {
+	nights := int(b.CheckOutDate.Sub(b.CheckInDate).Hours() / 24)
+	if nights <= 0 {
+		return 0
+	}
+	if penaltyNights > nights {
+		penaltyNights = nights
+	}
+	return b.TotalPrice / float64(nights) * float64(penaltyNights)
+}
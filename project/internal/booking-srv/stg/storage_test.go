@@ -0,0 +1,571 @@
+package stg
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/exceptions"
+	"hotel-booking-system/internal/booking-srv/hotelclient/fakehotel"
+	"hotel-booking-system/internal/booking-srv/repository"
+	"hotel-booking-system/internal/booking-srv/repository/memory"
+	kafkamem "hotel-booking-system/internal/kafka/memory"
+	"hotel-booking-system/internal/package/requestctx"
+	"hotel-booking-system/package/events"
+)
+
+const (
+	testUser  = 1
+	testHotel = 1
+	testType  = 1
+	testPrice = 5000.0
+)
+
+// fakePayments records authorizations and voids by idempotency key.
+type fakePayments struct {
+	mu         sync.Mutex
+	declined   error
+	authorized map[string]float64
+	voided     []string
+}
+
+func (p *fakePayments) Authorize(_ context.Context, key string, _ int, amount float64) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.declined != nil {
+		return "", p.declined
+	}
+	p.authorized[key] = amount
+	return "pay-" + key, nil
+}
+
+func (p *fakePayments) Void(_ context.Context, key string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.voided = append(p.voided, key)
+	return nil
+}
+
+type fixture struct {
+	repo     *memory.Repository
+	hotel    *fakehotel.Client
+	bus      *kafkamem.Bus
+	payments *fakePayments
+	storage  *Storage
+}
+
+// newFixture sets up a user and a hotel with one room type of two rooms,
+// 101 and 102.
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	f := &fixture{
+		repo:     memory.New(),
+		hotel:    fakehotel.New(),
+		bus:      kafkamem.NewBus(),
+		payments: &fakePayments{authorized: make(map[string]float64)},
+	}
+	f.repo.AddUser(repository.User{ID: testUser, Email: "guest@example.com", FullName: "Guest", Phone: "+79990000000"})
+	f.hotel.AddRoomType(fakehotel.RoomType{
+		HotelID:   testHotel,
+		ID:        testType,
+		HotelName: "Grand",
+		Address:   "Main st. 1",
+		Phone:     "+78120000000",
+		Name:      "Double",
+		Price:     testPrice,
+		RoomIDs:   []int{101, 102},
+	})
+	f.storage = NewStorage(f.repo, f.hotel, f.bus, f.payments)
+	return f
+}
+
+// day returns midnight UTC n days from today.
+func day(n int) time.Time {
+	return time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, n)
+}
+
+func bookingInfo(from, to int) BookingInfo {
+	return BookingInfo{
+		UserID:       testUser,
+		HotelID:      testHotel,
+		RoomTypeID:   testType,
+		CheckInDate:  day(from),
+		CheckOutDate: day(to),
+		GuestsCount:  2,
+	}
+}
+
+func (f *fixture) create(t *testing.T, info BookingInfo) *repository.Booking {
+	t.Helper()
+	id, err := f.storage.CreateBooking(context.Background(), info)
+	if err != nil {
+		t.Fatalf("CreateBooking: %v", err)
+	}
+	return f.booking(t, id)
+}
+
+func (f *fixture) booking(t *testing.T, id int) *repository.Booking {
+	t.Helper()
+	b, err := f.repo.GetBooking(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// addBooking stores a booking of the test user directly in the repository.
+func (f *fixture) addBooking(roomID, from, to int, status string, total float64) int {
+	return f.repo.AddBooking(repository.Booking{
+		UserID:       testUser,
+		HotelID:      testHotel,
+		RoomID:       roomID,
+		CheckInDate:  day(from),
+		CheckOutDate: day(to),
+		GuestsCount:  1,
+		TotalPrice:   total,
+		Status:       status,
+	})
+}
+
+// relay publishes the outbox and returns the events published by this call.
+func (f *fixture) relay(t *testing.T) []events.Event {
+	t.Helper()
+	before := len(f.bus.Messages(""))
+	if _, err := f.storage.RelayOutbox(context.Background(), 100); err != nil {
+		t.Fatal(err)
+	}
+
+	var result []events.Event
+	for _, m := range f.bus.Messages("")[before:] {
+		event, err := m.Event()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Topic != event.EventType() {
+			t.Errorf("event %s published to %s", event.EventType(), m.Topic)
+		}
+		result = append(result, event)
+	}
+	return result
+}
+
+func (f *fixture) balance(t *testing.T) int {
+	t.Helper()
+	balance, err := f.repo.GetLoyaltyBalance(context.Background(), testUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return balance
+}
+
+func eventTypes(list []events.Event) string {
+	var types []string
+	for _, e := range list {
+		types = append(types, e.EventType())
+	}
+	return strings.Join(types, ",")
+}
+
+func TestBookingLifecycle(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	b := f.create(t, bookingInfo(10, 12))
+	if b.Status != repository.StatusConfirmed || b.RoomID != 101 || b.TotalPrice != 2*testPrice {
+		t.Fatalf("created %+v", b)
+	}
+	if b.HotelName != "Grand" || b.RoomTypeName != "Double" {
+		t.Errorf("hotel snapshot %q / %q", b.HotelName, b.RoomTypeName)
+	}
+
+	published := f.relay(t)
+	if len(published) != 1 {
+		t.Fatalf("published %s, want booking created", eventTypes(published))
+	}
+	created, ok := published[0].(*events.BookingCreatedEvent)
+	if !ok || created.BookingID != b.ID || created.UserEmail != "guest@example.com" || created.Amount != 2*testPrice {
+		t.Errorf("created event %+v", published[0])
+	}
+
+	if err := f.storage.CheckOut(ctx, b.ID); !errors.Is(err, exceptions.ErrBookingStatus) {
+		t.Errorf("check-out before check-in: %v", err)
+	}
+	if err := f.storage.CheckIn(ctx, b.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.storage.CheckIn(ctx, b.ID); !errors.Is(err, exceptions.ErrBookingStatus) {
+		t.Errorf("second check-in: %v", err)
+	}
+	if err := f.storage.CheckOut(ctx, b.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	published = f.relay(t)
+	if got := eventTypes(published); got != events.TypeBookingCheckedIn+","+events.TypeBookingCheckedOut {
+		t.Fatalf("published %s", got)
+	}
+	checkedOut := published[1].(*events.BookingCheckedOutEvent)
+	if checkedOut.PointsEarned != 100 {
+		t.Errorf("earned %d points, want 100", checkedOut.PointsEarned)
+	}
+
+	status, err := f.storage.GetLoyaltyStatus(ctx, testUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Balance != 100 || status.Tier != TierBasic {
+		t.Errorf("loyalty %+v", status)
+	}
+}
+
+func TestCheckOutTierMultiplier(t *testing.T) {
+	f := newFixture(t)
+	// Nine nights this year plus the two of this stay reach silver.
+	year := time.Now().Year()
+	f.repo.AddBooking(repository.Booking{
+		UserID:       testUser,
+		HotelID:      testHotel,
+		RoomID:       102,
+		CheckInDate:  time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+		CheckOutDate: time.Date(year, time.January, 10, 0, 0, 0, 0, time.UTC),
+		Status:       repository.StatusCheckedOut,
+	})
+	id := f.addBooking(101, -2, 0, repository.StatusCheckedIn, 10000)
+
+	if err := f.storage.CheckOut(context.Background(), id); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.balance(t); got != 125 {
+		t.Errorf("balance %d, want 125", got)
+	}
+}
+
+func TestCancelBooking(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	paid := f.addBooking(102, -30, -29, repository.StatusCheckedOut, 0)
+	f.repo.AddLedgerEntry(testUser, paid, repository.LedgerEarn, 1500)
+
+	info := bookingInfo(10, 12)
+	info.RedeemPoints = 1000
+	b := f.create(t, info)
+	if b.TotalPrice != 2*testPrice-1000 || b.LoyaltyDiscount != 1000 {
+		t.Fatalf("price %.2f, discount %.2f", b.TotalPrice, b.LoyaltyDiscount)
+	}
+	if got := f.balance(t); got != 500 {
+		t.Fatalf("balance after redeeming %d, want 500", got)
+	}
+	f.relay(t)
+
+	if err := f.storage.CancelBooking(ctx, b.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.booking(t, b.ID).Status; got != repository.StatusCancelled {
+		t.Errorf("status %s", got)
+	}
+	if got := f.balance(t); got != 1500 {
+		t.Errorf("balance after cancelling %d, want 1500", got)
+	}
+
+	published := f.relay(t)
+	cancelled, ok := published[0].(*events.BookingCancelledEvent)
+	if len(published) != 1 || !ok || cancelled.PreviousStatus != repository.StatusConfirmed {
+		t.Errorf("published %+v", published)
+	}
+
+	if err := f.storage.CancelBooking(ctx, b.ID); !errors.Is(err, exceptions.ErrBookingStatus) {
+		t.Errorf("second cancel: %v", err)
+	}
+	if err := f.storage.CancelBooking(ctx, 999); !errors.Is(err, exceptions.ErrNotFound) {
+		t.Errorf("unknown booking: %v", err)
+	}
+
+	// The cancelled booking no longer takes the room.
+	if again := f.create(t, bookingInfo(10, 12)); again.RoomID != 101 {
+		t.Errorf("room %d, want the released 101", again.RoomID)
+	}
+}
+
+func TestModifyBooking(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	paid := f.addBooking(102, -30, -29, repository.StatusCheckedOut, 0)
+	f.repo.AddLedgerEntry(testUser, paid, repository.LedgerEarn, 1000)
+
+	info := bookingInfo(10, 12)
+	info.RedeemPoints = 1000
+	b := f.create(t, info)
+	f.relay(t)
+
+	if err := f.storage.ModifyBooking(ctx, b.ID, ModifyInfo{CheckInDate: day(10), CheckOutDate: day(13), GuestsCount: 3}); err != nil {
+		t.Fatal(err)
+	}
+	modified := f.booking(t, b.ID)
+	if modified.TotalPrice != 3*testPrice-1000 || modified.GuestsCount != 3 || !modified.CheckOutDate.Equal(day(13)) {
+		t.Errorf("modified %+v", modified)
+	}
+
+	published := f.relay(t)
+	event, ok := published[0].(*events.BookingModifiedEvent)
+	if len(published) != 1 || !ok {
+		t.Fatalf("published %s", eventTypes(published))
+	}
+	if event.Before.TotalPrice != 2*testPrice-1000 || event.After.TotalPrice != 3*testPrice-1000 {
+		t.Errorf("terms %+v -> %+v", event.Before, event.After)
+	}
+
+	f.addBooking(101, 14, 16, repository.StatusConfirmed, 0)
+	err := f.storage.ModifyBooking(ctx, b.ID, ModifyInfo{CheckInDate: day(10), CheckOutDate: day(15), GuestsCount: 2})
+	if !errors.Is(err, exceptions.ErrRoomNotAvailable) {
+		t.Errorf("overlapping dates: %v", err)
+	}
+	if got := f.booking(t, b.ID); !got.CheckOutDate.Equal(day(13)) {
+		t.Errorf("failed change was stored: %+v", got)
+	}
+
+	for name, change := range map[string]ModifyInfo{
+		"reversed dates": {CheckInDate: day(12), CheckOutDate: day(10), GuestsCount: 2},
+		"no guests":      {CheckInDate: day(10), CheckOutDate: day(12)},
+	} {
+		if err := f.storage.ModifyBooking(ctx, b.ID, change); err == nil {
+			t.Errorf("%s accepted", name)
+		}
+	}
+}
+
+func TestProcessNoShows(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	f.repo.SetPolicy(2, memory.Policy{NoShowCutoff: 18 * time.Hour, PenaltyNights: 2})
+
+	missed := f.addBooking(101, -2, 1, repository.StatusConfirmed, 9000)
+	strict := f.repo.AddBooking(repository.Booking{
+		UserID: testUser, HotelID: 2, RoomID: 201, CheckInDate: day(-1), CheckOutDate: day(2),
+		TotalPrice: 9000, Status: repository.StatusConfirmed,
+	})
+	f.addBooking(102, -2, 1, repository.StatusCheckedIn, 9000)
+	today := f.addBooking(102, 0, 1, repository.StatusConfirmed, 3000)
+
+	processed, err := f.storage.ProcessNoShows(ctx, day(0).Add(12*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if processed != 2 {
+		t.Fatalf("processed %d, want 2", processed)
+	}
+
+	for id, penalty := range map[int]float64{missed: 3000, strict: 6000} {
+		if got := f.booking(t, id).Status; got != repository.StatusNoShow {
+			t.Errorf("booking %d is %s", id, got)
+		}
+		if got := f.repo.Penalty(id); got != penalty {
+			t.Errorf("booking %d penalty %.2f, want %.2f", id, got, penalty)
+		}
+	}
+	if got := f.booking(t, today).Status; got != repository.StatusConfirmed {
+		t.Errorf("booking before its cutoff is %s", got)
+	}
+
+	published := f.relay(t)
+	if got := eventTypes(published); got != events.TypeBookingNoShow+","+events.TypeBookingNoShow {
+		t.Errorf("published %s", got)
+	}
+}
+
+func TestRelayOutboxRetries(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	now := time.Now()
+	f.repo.SetClock(func() time.Time { return now })
+
+	f.create(t, bookingInfo(10, 12))
+	f.bus.Fail(errors.New("broker down"))
+	if sent, err := f.storage.RelayOutbox(ctx, 10); err != nil || sent != 0 {
+		t.Fatalf("sent %d, %v", sent, err)
+	}
+	pending := f.repo.PendingOutbox()
+	if len(pending) != 1 || pending[0].Attempts != 1 {
+		t.Fatalf("pending %+v", pending)
+	}
+
+	f.bus.Fail(nil)
+	if sent, _ := f.storage.RelayOutbox(ctx, 10); sent != 0 {
+		t.Errorf("message retried before its backoff")
+	}
+
+	now = now.Add(2 * time.Second)
+	if sent, _ := f.storage.RelayOutbox(ctx, 10); sent != 1 {
+		t.Errorf("sent %d after the backoff, want 1", sent)
+	}
+	if len(f.repo.PendingOutbox()) != 0 || len(f.bus.Messages(events.TypeBookingCreated)) != 1 {
+		t.Errorf("message not delivered exactly once")
+	}
+}
+
+func TestRelayOutboxKeepsKeyOrder(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	now := time.Now()
+	f.repo.SetClock(func() time.Time { return now })
+
+	first := f.create(t, bookingInfo(10, 12))
+	if err := f.storage.CancelBooking(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	second := f.create(t, bookingInfo(20, 22))
+
+	f.bus.Fail(errors.New("broker down"))
+	if sent, err := f.storage.RelayOutbox(ctx, 10); err != nil || sent != 0 {
+		t.Fatalf("sent %d, %v", sent, err)
+	}
+	pending := f.repo.PendingOutbox()
+	if len(pending) != 3 || pending[0].Attempts != 1 || pending[1].Attempts != 0 {
+		t.Fatalf("the batch went on after a failure: %+v", pending)
+	}
+
+	// The cancellation waits for the creation of its booking; other
+	// bookings go ahead.
+	f.bus.Fail(nil)
+	if sent, _ := f.storage.RelayOutbox(ctx, 10); sent != 1 {
+		t.Fatalf("sent %d, want 1", sent)
+	}
+	if published := f.bus.Messages(""); len(published) != 1 || published[0].Key != strconv.Itoa(second.ID) {
+		t.Fatalf("published %+v", published)
+	}
+
+	now = now.Add(2 * time.Second)
+	if sent, _ := f.storage.RelayOutbox(ctx, 10); sent != 2 {
+		t.Fatalf("sent %d after the backoff, want 2", sent)
+	}
+	var order []string
+	for _, m := range f.bus.Messages("") {
+		if m.Key == strconv.Itoa(first.ID) {
+			order = append(order, m.Topic)
+		}
+	}
+	if strings.Join(order, ",") != events.TypeBookingCreated+","+events.TypeBookingCancelled {
+		t.Errorf("events of booking %d published as %v", first.ID, order)
+	}
+}
+
+func TestCreateReview(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	stayed := f.addBooking(101, -5, -3, repository.StatusCheckedOut, 10000)
+	upcoming := f.addBooking(101, 5, 7, repository.StatusConfirmed, 10000)
+
+	review := ReviewInfo{UserID: testUser, BookingID: stayed, Rating: 5, Text: "Great"}
+	if _, err := f.storage.CreateReview(ctx, review); err != nil {
+		t.Fatal(err)
+	}
+	reviews := f.hotel.Reviews()
+	if len(reviews) != 1 || reviews[0].HotelId != testHotel || reviews[0].Text != "Great" {
+		t.Errorf("reviews %v", reviews)
+	}
+
+	for name, tc := range map[string]struct {
+		info ReviewInfo
+		want error
+	}{
+		"duplicate":  {review, exceptions.ErrHotelConflict},
+		"other user": {ReviewInfo{UserID: 2, BookingID: stayed, Rating: 5}, exceptions.ErrNotBookingOwner},
+		"not stayed": {ReviewInfo{UserID: testUser, BookingID: upcoming, Rating: 5}, exceptions.ErrBookingStatus},
+		"bad rating": {ReviewInfo{UserID: testUser, BookingID: stayed, Rating: 6}, exceptions.ErrInvalidRequest},
+		"unknown":    {ReviewInfo{UserID: testUser, BookingID: 999, Rating: 5}, exceptions.ErrNotFound},
+	} {
+		if _, err := f.storage.CreateReview(ctx, tc.info); !errors.Is(err, tc.want) {
+			t.Errorf("%s: got %v, want %v", name, err, tc.want)
+		}
+	}
+}
+
+func TestBookingHistory(t *testing.T) {
+	f := newFixture(t)
+	ctx := requestctx.WithActor(context.Background(), "user:1")
+	ctx = requestctx.WithRequestID(ctx, "req-1")
+
+	id, err := f.storage.CreateBooking(ctx, bookingInfo(10, 12))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.storage.CancelBooking(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := f.storage.GetBookingHistory(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actions []string
+	for _, e := range history {
+		actions = append(actions, e.Action)
+		if e.Actor != "user:1" || e.RequestID != "req-1" {
+			t.Errorf("%s recorded by %q in %q", e.Action, e.Actor, e.RequestID)
+		}
+	}
+	want := []string{repository.ActionCreated, repository.ActionConfirmed, repository.ActionCancelled}
+	if strings.Join(actions, ",") != strings.Join(want, ",") {
+		t.Errorf("actions %v, want %v", actions, want)
+	}
+	if history[0].Before != nil || !strings.Contains(string(history[2].After), `"status":"cancelled"`) {
+		t.Errorf("snapshots %s -> %s", history[0].Before, history[2].After)
+	}
+
+	if _, err := f.storage.GetBookingHistory(ctx, 999); !errors.Is(err, exceptions.ErrNotFound) {
+		t.Errorf("unknown booking: %v", err)
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	b := f.create(t, bookingInfo(10, 12))
+
+	token, err := f.storage.CreateCalendarToken(ctx, testUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calendar, err := f.storage.GetUserCalendar(ctx, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calendar) != 1 || !calendar[0].Start.Equal(b.CheckInDate) {
+		t.Errorf("user calendar %+v", calendar)
+	}
+
+	if _, err := f.storage.CreateCalendarToken(ctx, testUser); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.storage.GetUserCalendar(ctx, token); !errors.Is(err, exceptions.ErrNotFound) {
+		t.Errorf("revoked token: %v", err)
+	}
+
+	feed := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\nUID:ext-1\r\nDTSTART;VALUE=DATE:" + day(10).Format("20060102") +
+		"\r\nDTEND;VALUE=DATE:" + day(12).Format("20060102") + "\r\nSUMMARY:Channel\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	if _, err := f.storage.ImportRoomCalendar(ctx, testHotel+1, testType, 102, "channel", strings.NewReader(feed)); !errors.Is(err, exceptions.ErrNotFound) {
+		t.Errorf("import into another hotel's room: %v", err)
+	}
+	if _, err := f.storage.ImportRoomCalendar(ctx, testHotel, testType, 103, "channel", strings.NewReader(feed)); !errors.Is(err, exceptions.ErrNotFound) {
+		t.Errorf("import into a room of another type: %v", err)
+	}
+	imported, err := f.storage.ImportRoomCalendar(ctx, testHotel, testType, 102, "channel", strings.NewReader(feed))
+	if err != nil || imported != 1 {
+		t.Fatalf("imported %d, %v", imported, err)
+	}
+
+	room, err := f.storage.GetRoomCalendar(ctx, 102)
+	if err != nil || len(room) != 1 {
+		t.Fatalf("room calendar %+v, %v", room, err)
+	}
+
+	// Both rooms are now taken for these dates.
+	if _, err := f.storage.CreateBooking(ctx, bookingInfo(10, 12)); !errors.Is(err, exceptions.ErrRoomNotAvailable) {
+		t.Errorf("booking over an imported block: %v", err)
+	}
+}
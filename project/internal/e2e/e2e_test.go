@@ -0,0 +1,177 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/repository"
+	hotelrepo "hotel-booking-system/internal/hotel-srv/repository"
+	"hotel-booking-system/internal/notification/smtpsink"
+	"hotel-booking-system/package/api/openapi/bookingclient"
+	"hotel-booking-system/package/events"
+)
+
+const (
+	guestEmail = "anna@example.com"
+	price      = 5000.0
+)
+
+// seed creates a user and a hotel with one room type of two rooms.
+func seed(t *testing.T, h *Harness) (hotelID, roomTypeID int) {
+	t.Helper()
+	ctx := context.Background()
+	h.BookingMemory.AddUser(repository.User{ID: 1, Email: guestEmail, FullName: "Анна Петрова"})
+
+	hotel := &hotelrepo.Hotel{Name: "Гранд", Address: "Москва, Тверская 1", ContactPhone: "+7 (495) 123-45-67"}
+	if err := h.Hotels.CreateHotel(ctx, hotel); err != nil {
+		t.Fatal(err)
+	}
+	roomTypeID = h.HotelMemory.AddRoomType(hotel.ID, "Double", price)
+	for _, number := range []string{"101", "102"} {
+		if _, err := h.Hotels.AddRoom(ctx, hotel.ID, roomTypeID, number); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h.Published(t, events.TypeRoomsChanged, 2)
+	return hotel.ID, roomTypeID
+}
+
+func createBooking(t *testing.T, h *Harness, hotelID, roomTypeID, nights int) int {
+	t.Helper()
+	checkIn := time.Now().AddDate(0, 0, 10).Truncate(24 * time.Hour)
+	actor := "guest"
+	resp, err := h.Client.CreateBookingWithResponse(context.Background(), &bookingclient.CreateBookingParams{XActor: &actor},
+		bookingclient.CreateBookingRequest{
+			UserId:       1,
+			HotelId:      hotelID,
+			RoomTypeId:   roomTypeID,
+			GuestsCount:  2,
+			CheckInDate:  checkIn,
+			CheckOutDate: checkIn.AddDate(0, 0, nights),
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("create booking: %s %s", resp.Status(), resp.Body)
+	}
+	return resp.JSON200.BookingId
+}
+
+func expectOK(t *testing.T, resp interface{ StatusCode() int }, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("status %d", resp.StatusCode())
+	}
+}
+
+func subjects(messages []smtpsink.Message) []string {
+	var result []string
+	for _, m := range messages {
+		result = append(result, m.Subject)
+	}
+	return result
+}
+
+func TestBookingConfirmationEmail(t *testing.T) {
+	h := Start(t, Options{})
+	hotelID, roomTypeID := seed(t, h)
+
+	bookingID := createBooking(t, h, hotelID, roomTypeID, 2)
+	h.Deliver(t)
+
+	messages := h.Mail.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("sent %d e-mails, want 1", len(messages))
+	}
+	m := messages[0]
+	if m.From != FromEmail || len(m.To) != 1 || m.To[0] != guestEmail {
+		t.Errorf("e-mail from %s to %v", m.From, m.To)
+	}
+	if m.Subject != "Подтверждение бронирования" {
+		t.Errorf("subject %q", m.Subject)
+	}
+	for _, want := range []string{
+		"Анна Петрова",
+		fmt.Sprintf(">%d<", bookingID),
+		"Гранд",
+		"Москва, Тверская 1",
+		"Double",
+		"$10000.00",
+	} {
+		if !strings.Contains(m.Body, want) {
+			t.Errorf("e-mail does not contain %q", want)
+		}
+	}
+}
+
+func TestBookingLifecycleEmails(t *testing.T) {
+	h := Start(t, Options{})
+	hotelID, roomTypeID := seed(t, h)
+	ctx := context.Background()
+
+	stay := createBooking(t, h, hotelID, roomTypeID, 2)
+	checkIn, err := h.Client.CheckInWithResponse(ctx, nil, bookingclient.BookingActionRequest{BookingId: stay})
+	expectOK(t, checkIn, err)
+	checkOut, err := h.Client.CheckOutWithResponse(ctx, nil, bookingclient.BookingActionRequest{BookingId: stay})
+	expectOK(t, checkOut, err)
+
+	cancelled := createBooking(t, h, hotelID, roomTypeID, 1)
+	cancel, err := h.Client.CancelBookingWithResponse(ctx, nil, bookingclient.BookingActionRequest{BookingId: cancelled})
+	expectOK(t, cancel, err)
+
+	h.Deliver(t)
+
+	messages := h.Mail.Messages()
+	want := []string{
+		"Подтверждение бронирования",
+		"Добро пожаловать",
+		"Спасибо, что остановились у нас",
+		"Подтверждение бронирования",
+		"Бронирование отменено",
+	}
+	if got := subjects(messages); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("subjects %q, want %q", got, want)
+	}
+	if body := messages[2].Body; !strings.Contains(body, "Total Paid") || !strings.Contains(body, "$10000.00") {
+		t.Errorf("check-out e-mail without the total:\n%s", body)
+	}
+	if body := messages[4].Body; !strings.Contains(body, fmt.Sprintf(">%d<", cancelled)) {
+		t.Errorf("cancellation e-mail without booking %d:\n%s", cancelled, body)
+	}
+}
+
+func TestPriceChangeReachesBooking(t *testing.T) {
+	h := Start(t, Options{})
+	hotelID, roomTypeID := seed(t, h)
+
+	createBooking(t, h, hotelID, roomTypeID, 1)
+
+	// The price is cached now; the change event from hotel-srv drops it.
+	before := h.Cache.Stats()
+	if err := h.Hotels.UpdateRoomPrice(context.Background(), hotelID, roomTypeID, 6500); err != nil {
+		t.Fatal(err)
+	}
+	h.Published(t, events.TypeRoomPriceChanged, 1)
+	if stats := h.Cache.Stats(); stats.Invalidations == before.Invalidations {
+		t.Errorf("cache not invalidated: %+v", stats)
+	}
+
+	createBooking(t, h, hotelID, roomTypeID, 1)
+	h.Deliver(t)
+
+	messages := h.Mail.Messages()
+	if len(messages) != 2 {
+		t.Fatalf("sent %d e-mails, want 2", len(messages))
+	}
+	if !strings.Contains(messages[0].Body, "$5000.00") || !strings.Contains(messages[1].Body, "$6500.00") {
+		t.Errorf("amounts do not follow the price change:\n%s\n%s", messages[0].Body, messages[1].Body)
+	}
+}
@@ -0,0 +1,217 @@
+// Package e2e runs hotel-srv, booking-srv and the notification handler in
+// one process for end-to-end tests: hotel-srv is served over an in-memory
+// gRPC listener, booking-srv over httptest, events go through the in-memory
+// bus and e-mails end up in an SMTP sink.
+package e2e
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"hotel-booking-system/internal/booking-srv/hotelclient"
+	bookingmem "hotel-booking-system/internal/booking-srv/repository/memory"
+	bookingserver "hotel-booking-system/internal/booking-srv/server"
+	bookingstg "hotel-booking-system/internal/booking-srv/stg"
+	"hotel-booking-system/internal/handler"
+	hotelmem "hotel-booking-system/internal/hotel-srv/repository/memory"
+	hotelserver "hotel-booking-system/internal/hotel-srv/server"
+	hotelstg "hotel-booking-system/internal/hotel-srv/stg"
+	kafkamem "hotel-booking-system/internal/kafka/memory"
+	"hotel-booking-system/internal/notification/smtpsink"
+	"hotel-booking-system/internal/package/apperr"
+	"hotel-booking-system/internal/package/health"
+	"hotel-booking-system/internal/package/middleware"
+	"hotel-booking-system/package/api/openapi/bookingclient"
+	"hotel-booking-system/package/events"
+	hotelv1 "hotel-booking-system/package/proto/fast/stable"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	bufSize = 1 << 20
+	// FromEmail is the sender of every e-mail.
+	FromEmail = "booking@hotel.test"
+)
+
+// NotificationTopics are the booking events the notification service
+// consumes.
+var NotificationTopics = []string{
+	events.TypeBookingCreated,
+	events.TypeBookingCancelled,
+	events.TypeBookingModified,
+	events.TypeBookingCheckedIn,
+	events.TypeBookingCheckedOut,
+	events.TypeBookingNoShow,
+}
+
+// Options swap the parts of the system a test wants to control.
+type Options struct {
+	// BookingRepo and HotelRepo replace the in-memory repositories, e.g.
+	// with the Postgres ones. The caller seeds them.
+	BookingRepo bookingstg.Repository
+	HotelRepo   hotelstg.Repository
+	// HotelClient configures the retries of the hotel-srv client.
+	HotelClient hotelclient.Config
+	// HotelCache is enabled by default.
+	HotelCache *hotelclient.CacheConfig
+}
+
+// Harness is a running system. BookingMemory and HotelMemory are nil when
+// the repository was replaced through Options.
+type Harness struct {
+	Bus   *kafkamem.Bus
+	Mail  *smtpsink.Sink
+	Cache *hotelclient.Cache
+
+	Hotels   *hotelstg.Storage
+	Bookings *bookingstg.Storage
+
+	HotelMemory   *hotelmem.Repository
+	BookingMemory *bookingmem.Repository
+
+	// URL is the booking-srv HTTP address and Client a client for it.
+	URL    string
+	Client *bookingclient.ClientWithResponses
+}
+
+// Start wires the services together and stops them when the test ends.
+// It changes the working directory to the module root, where the e-mail
+// templates are, and points the notification SMTP settings at the sink, so
+// tests using it cannot run in parallel.
+func Start(t *testing.T, opts Options) *Harness {
+	t.Helper()
+	h := &Harness{Bus: kafkamem.NewBus()}
+	t.Cleanup(h.Bus.Close)
+
+	sink, err := smtpsink.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sink.Close() })
+	h.Mail = sink
+
+	_, file, _, _ := runtime.Caller(0)
+	t.Chdir(filepath.Join(filepath.Dir(file), "..", ".."))
+	t.Setenv("SMTP_ADDR", sink.Addr())
+	t.Setenv("FROM_EMAIL", FromEmail)
+	t.Setenv("FROM_EMAIL_PASSWORD", "secret")
+	t.Setenv("FROM_EMAIL_SMTP", "127.0.0.1")
+
+	conn := h.startHotel(t, opts)
+	h.startBooking(t, opts, conn)
+
+	h.Bus.Subscribe(handler.NewHandler(), NotificationTopics...)
+	return h
+}
+
+// startHotel serves hotel-srv over bufconn and returns a connection to it.
+func (h *Harness) startHotel(t *testing.T, opts Options) *grpc.ClientConn {
+	t.Helper()
+	repo := opts.HotelRepo
+	if repo == nil {
+		h.HotelMemory = hotelmem.New()
+		repo = h.HotelMemory
+	}
+	h.Hotels = hotelstg.NewStorage(repo, h.Bus)
+
+	hotelServer := hotelserver.NewHotelServer(h.Hotels, health.NewChecker())
+	hotelServer.SetServer()
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		middleware.UnaryServerRequestID,
+		apperr.UnaryServerInterceptor,
+		middleware.UnaryServerRecover,
+	))
+	hotelv1.RegisterHotelServiceServer(grpcServer, hotelServer)
+
+	listener := bufconn.Listen(bufSize)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(middleware.UnaryClientRequestID),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// startBooking serves booking-srv HTTP on top of the hotel-srv connection.
+func (h *Harness) startBooking(t *testing.T, opts Options, conn *grpc.ClientConn) {
+	t.Helper()
+	repo := opts.BookingRepo
+	if repo == nil {
+		h.BookingMemory = bookingmem.New()
+		repo = h.BookingMemory
+	}
+
+	clientCfg := opts.HotelClient
+	if clientCfg == (hotelclient.Config{}) {
+		clientCfg = hotelclient.DefaultConfig()
+	}
+	cacheCfg := hotelclient.DefaultCacheConfig()
+	if opts.HotelCache != nil {
+		cacheCfg = *opts.HotelCache
+	}
+	h.Cache = hotelclient.NewCache(hotelclient.New(hotelv1.NewHotelServiceClient(conn), clientCfg), cacheCfg)
+	h.Bus.Subscribe(hotelclient.NewInvalidator(h.Cache), hotelclient.InvalidationTopics...)
+
+	h.Bookings = bookingstg.NewStorage(repo, h.Cache, h.Bus, bookingstg.NoopPayments{})
+	bookingServer := bookingserver.NewBookingServer(h.Bookings, health.NewChecker())
+	bookingServer.SetServer()
+
+	srv := httptest.NewServer(middleware.Chain(bookingServer.Mux, middleware.Default(4<<20, nil)...))
+	t.Cleanup(srv.Close)
+	h.URL = srv.URL
+
+	client, err := bookingclient.NewClientWithResponses(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Client = client
+}
+
+// Deliver publishes the booking events waiting in the outbox, as the relay
+// job does, and waits until the subscribers have handled them.
+func (h *Harness) Deliver(t *testing.T) {
+	t.Helper()
+	for {
+		n, err := h.Bookings.RelayOutbox(context.Background(), 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n == 0 {
+			break
+		}
+	}
+	h.Bus.Wait()
+}
+
+// Published waits until want events were published to topic, for the
+// events hotel-srv sends in the background, and until the subscribers have
+// handled them.
+func (h *Harness) Published(t *testing.T, topic string, want int) []kafkamem.Message {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for len(h.Bus.Messages(topic)) < want {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d %s events published, want %d", len(h.Bus.Messages(topic)), topic, want)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	h.Bus.Wait()
+	return h.Bus.Messages(topic)
+}
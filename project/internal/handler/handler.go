@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -11,19 +12,82 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-type Handler struct{}
+type eventHandler func(ctx context.Context, env *events.Envelope, event events.Event) error
+
+type Handler struct {
+	routes map[events.Key]eventHandler
+}
 
 func NewHandler() *Handler {
-	return &Handler{}
+	h := &Handler{}
+	h.routes = map[events.Key]eventHandler{
+		{Type: events.TypeBookingCreated, Version: 1}:    h.handleBookingCreated,
+		{Type: events.TypeBookingCancelled, Version: 1}:  h.handleBookingCancelled,
+		{Type: events.TypeBookingModified, Version: 1}:   h.handleBookingModified,
+		{Type: events.TypeBookingCheckedIn, Version: 1}:  h.handleBookingCheckedIn,
+		{Type: events.TypeBookingCheckedOut, Version: 1}: h.handleBookingCheckedOut,
+		{Type: events.TypeBookingNoShow, Version: 1}:     h.handleBookingNoShow,
+	}
+	return h
 }
 
-func (h *Handler) HandleMessage(message []byte, topic kafka.TopicPartition, cn int) error {
-	var event events.BookingCreatedEvent
-	if err := json.Unmarshal(message, &event); err != nil {
+// HandleMessage dispatches the message on its event type and schema version.
+// Events this service does not handle are skipped.
+func (h *Handler) HandleMessage(ctx context.Context, message []byte, topic kafka.TopicPartition, cn int) error {
+	env, err := decodeEnvelope(message, topic)
+	if err != nil {
 		logrus.Errorf("Failed to parse event JSON: %v", err)
 		return nil
 	}
 
+	route, ok := h.routes[events.Key{Type: env.Type, Version: env.SchemaVersion}]
+	if !ok {
+		logrus.Warnf("Skipping unsupported event %s v%d", env.Type, env.SchemaVersion)
+		return nil
+	}
+
+	// The request ID of the booking-srv call that caused the event ties
+	// these log lines to the producer's.
+	log := logrus.WithFields(logrus.Fields{
+		"event_id":   env.EventID,
+		"request_id": env.TraceContext["x-request-id"],
+	})
+	log.Infof("Handling %s v%d", env.Type, env.SchemaVersion)
+
+	event, err := env.Decode()
+	if err != nil {
+		log.Errorf("Failed to decode event: %v", err)
+		return nil
+	}
+
+	return route(ctx, env, event)
+}
+
+// decodeEnvelope also accepts the bare payloads published before the
+// envelope was introduced and takes their type from the topic.
+func decodeEnvelope(message []byte, topic kafka.TopicPartition) (*events.Envelope, error) {
+	var env events.Envelope
+	if err := json.Unmarshal(message, &env); err != nil {
+		return nil, err
+	}
+	if env.Type != "" {
+		return &env, nil
+	}
+
+	var topicName string
+	if topic.Topic != nil {
+		topicName = *topic.Topic
+	}
+	return &events.Envelope{
+		Type:          topicName,
+		SchemaVersion: 1,
+		Data:          message,
+	}, nil
+}
+
+func (h *Handler) handleBookingCreated(ctx context.Context, env *events.Envelope, e events.Event) error {
+	event := e.(*events.BookingCreatedEvent)
+
 	logrus.Infof("Processing booking ID: %d for email: %s", event.BookingID, event.UserEmail)
 
 	reqBody := notification.EmailWithTemplateRequestBody{
@@ -31,14 +95,20 @@ func (h *Handler) HandleMessage(message []byte, topic kafka.TopicPartition, cn i
 		Subject:  "Подтверждение бронирования",
 		Template: "hello_email",
 		Vars: map[string]string{
-			"UserName":  event.UserName,
-			"BookingID": fmt.Sprintf("%d", event.BookingID),
-			"UserEmail": event.UserEmail,
-			"Amount":    fmt.Sprintf("%.2f", event.Amount),
+			"UserName":     event.UserName,
+			"BookingID":    fmt.Sprintf("%d", event.BookingID),
+			"UserEmail":    event.UserEmail,
+			"Amount":       fmt.Sprintf("%.2f", event.Amount),
+			"HotelName":    event.HotelName,
+			"HotelAddress": event.HotelAddress,
+			"HotelPhone":   event.HotelPhone,
+			"RoomTypeName": event.RoomTypeName,
+			"CheckInDate":  event.CheckInDate,
+			"CheckOutDate": event.CheckOutDate,
 		},
 	}
 
-	if err := notification.SendEmailLogic(reqBody); err != nil {
+	if err := notification.SendEmailLogic(ctx, reqBody); err != nil {
 		logrus.Errorf("Failed to send email: %v", err)
 		return nil
 	}
@@ -46,3 +116,100 @@ func (h *Handler) HandleMessage(message []byte, topic kafka.TopicPartition, cn i
 	logrus.Info("Email sent successfully via Kafka handler")
 	return nil
 }
+
+func (h *Handler) handleBookingCancelled(ctx context.Context, env *events.Envelope, e events.Event) error {
+	event := e.(*events.BookingCancelledEvent)
+
+	return sendBookingUpdate(ctx, event.UserEmail, "Бронирование отменено", map[string]string{
+		"Title":        "Booking Cancelled",
+		"Message":      "Your booking has been cancelled. Any loyalty points earned or spent on it have been returned.",
+		"UserName":     event.UserName,
+		"BookingID":    fmt.Sprintf("%d", event.BookingID),
+		"CheckInDate":  event.CheckInDate,
+		"CheckOutDate": event.CheckOutDate,
+	})
+}
+
+func (h *Handler) handleBookingModified(ctx context.Context, env *events.Envelope, e events.Event) error {
+	event := e.(*events.BookingModifiedEvent)
+
+	return sendBookingUpdate(ctx, event.UserEmail, "Бронирование изменено", map[string]string{
+		"Title": "Booking Updated",
+		"Message": fmt.Sprintf("Your booking has been changed from %s – %s to the dates below, for %d guest(s).",
+			event.Before.CheckInDate, event.Before.CheckOutDate, event.After.GuestsCount),
+		"UserName":     event.UserName,
+		"BookingID":    fmt.Sprintf("%d", event.BookingID),
+		"CheckInDate":  event.After.CheckInDate,
+		"CheckOutDate": event.After.CheckOutDate,
+		"AmountLabel":  "New Total",
+		"Amount":       fmt.Sprintf("%.2f", event.After.TotalPrice),
+	})
+}
+
+func (h *Handler) handleBookingCheckedIn(ctx context.Context, env *events.Envelope, e events.Event) error {
+	event := e.(*events.BookingCheckedInEvent)
+
+	return sendBookingUpdate(ctx, event.UserEmail, "Добро пожаловать", map[string]string{
+		"Title":        "Welcome!",
+		"Message":      "You have checked in. We hope you enjoy your stay.",
+		"UserName":     event.UserName,
+		"BookingID":    fmt.Sprintf("%d", event.BookingID),
+		"CheckInDate":  event.CheckInDate,
+		"CheckOutDate": event.CheckOutDate,
+	})
+}
+
+func (h *Handler) handleBookingCheckedOut(ctx context.Context, env *events.Envelope, e events.Event) error {
+	event := e.(*events.BookingCheckedOutEvent)
+
+	return sendBookingUpdate(ctx, event.UserEmail, "Спасибо, что остановились у нас", map[string]string{
+		"Title":        "Thank You for Staying",
+		"Message":      fmt.Sprintf("You have checked out and earned %d loyalty points. We would love to hear your review.", event.PointsEarned),
+		"UserName":     event.UserName,
+		"BookingID":    fmt.Sprintf("%d", event.BookingID),
+		"CheckInDate":  event.CheckInDate,
+		"CheckOutDate": event.CheckOutDate,
+		"AmountLabel":  "Total Paid",
+		"Amount":       fmt.Sprintf("%.2f", event.TotalPrice),
+	})
+}
+
+func (h *Handler) handleBookingNoShow(ctx context.Context, env *events.Envelope, e events.Event) error {
+	event := e.(*events.BookingNoShowEvent)
+
+	return sendBookingUpdate(ctx, event.UserEmail, "Неявка по бронированию", map[string]string{
+		"Title":        "Missed Check-in",
+		"Message":      "You did not check in on time, so the booking was marked as a no-show and the room was released.",
+		"UserName":     event.UserName,
+		"BookingID":    fmt.Sprintf("%d", event.BookingID),
+		"CheckInDate":  event.CheckInDate,
+		"CheckOutDate": event.CheckOutDate,
+		"AmountLabel":  "No-show Penalty",
+		"Amount":       fmt.Sprintf("%.2f", event.Penalty),
+	})
+}
+
+// sendBookingUpdate renders the booking_update template. Like the
+// confirmation e-mail, delivery failures are logged and the message is not
+// retried.
+func sendBookingUpdate(ctx context.Context, toAddr, subject string, vars map[string]string) error {
+	if toAddr == "" {
+		logrus.Warnf("Skipping %q e-mail for booking %s: no recipient", subject, vars["BookingID"])
+		return nil
+	}
+
+	reqBody := notification.EmailWithTemplateRequestBody{
+		ToAddr:   toAddr,
+		Subject:  subject,
+		Template: "booking_update",
+		Vars:     vars,
+	}
+
+	if err := notification.SendEmailLogic(ctx, reqBody); err != nil {
+		logrus.Errorf("Failed to send email: %v", err)
+		return nil
+	}
+
+	logrus.Infof("Booking %s update email sent", vars["BookingID"])
+	return nil
+}
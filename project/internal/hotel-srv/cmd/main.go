@@ -1,21 +1,36 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"hotel-booking-system/internal/hotel-srv/config"
 	"hotel-booking-system/internal/hotel-srv/repository"
 	"hotel-booking-system/internal/hotel-srv/server"
 	"hotel-booking-system/internal/hotel-srv/stg"
+	"hotel-booking-system/internal/kafka"
+	"hotel-booking-system/internal/package/apperr"
 	db "hotel-booking-system/internal/package/database"
+	"hotel-booking-system/internal/package/health"
+	"hotel-booking-system/internal/package/metrics"
+	"hotel-booking-system/internal/package/middleware"
+	"hotel-booking-system/internal/package/migrate"
+	"hotel-booking-system/internal/package/tracing"
+	"hotel-booking-system/migrations"
+	"hotel-booking-system/package/events"
 	hotelv1 "hotel-booking-system/package/proto/fast/stable"
 
 	"github.com/joho/godotenv"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func main() {
@@ -26,42 +41,97 @@ func main() {
 		logrus.Warn("No .env file found")
 	}
 
-	dbConfig := db.Config{
-		Host:     os.Getenv("DB_HOST"),
-		Port:     5432,
-		User:     os.Getenv("DB_USER"),
-		Password: os.Getenv("DB_PASS"),
-		DBName:   os.Getenv("DB_NAME"),
-		SSLMode:  "disable",
+	cfg, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
 	}
+	if err != nil {
+		logrus.Fatalf("Invalid configuration: %v", err)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "hotel-service")
+	if err != nil {
+		logrus.Fatalf("Failed to set up tracing: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logrus.Errorf("Failed to flush traces: %v", err)
+		}
+	}()
 
-	hotelDB, err := db.Connect(dbConfig)
+	hotelDB, err := db.Connect(cfg.Database.Connection())
 	if err != nil {
 		logrus.Fatalf("Error connecting to hotel database: %v", err)
 	}
 	defer hotelDB.Close()
+
+	schema, err := migrate.Load(migrations.FS, "hotel")
+	if err != nil {
+		logrus.Fatalf("Failed to load migrations: %v", err)
+	}
+	migrator := migrate.New(hotelDB, schema)
+	if len(cfg.Command) > 0 {
+		if cfg.Command[0] != "migrate" {
+			logrus.Fatalf("Unknown command %q", cfg.Command[0])
+		}
+		if err := migrate.Run(context.Background(), migrator, cfg.Command[1:], os.Stdout); err != nil {
+			logrus.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+	if cfg.MigrateOnStart {
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			logrus.Fatalf("Failed to migrate hotel database: %v", err)
+		}
+		logrus.Infof("Applied %d migrations", applied)
+	}
+
+	codec, err := events.CodecFor(cfg.Kafka.ContentType)
+	if err != nil {
+		logrus.Fatalf("Invalid EVENT_CONTENT_TYPE: %v", err)
+	}
+	producer, err := kafka.NewProducer(cfg.Kafka.Brokers, codec)
+	if err != nil {
+		logrus.Fatalf("Failed to create kafka producer: %v", err)
+	}
+	defer producer.Close()
+
 	repo := repository.NewRepository(hotelDB)
-	storage := stg.NewStorage(repo)
-	hotelServer := server.NewHotelServer(storage)
+	storage := stg.NewStorage(repo, producer)
+	readiness := health.NewChecker()
+	readiness.Add("database", hotelDB.PingContext)
+	readiness.Add("kafka", producer.Ping)
+	hotelServer := server.NewHotelServer(storage, readiness)
 	hotelServer.SetServer()
 	go func() {
-		port := os.Getenv("HTTP_PORT")
-		if port == "" {
-			port = ":8081"
-		}
-		logrus.Infof("Starting hotel HTTP server on %s", port)
-		if err := http.ListenAndServe(port, hotelServer.Mux); err != nil {
+		corsOrigins := middleware.Origins(cfg.CORSOrigins)
+		handler := middleware.Chain(tracing.HTTP(metrics.HTTP(hotelServer.Mux)), middleware.Default(1<<20, corsOrigins)...)
+
+		logrus.Infof("Starting hotel HTTP server on %s", cfg.HTTPAddr)
+		if err := http.ListenAndServe(cfg.HTTPAddr, handler); err != nil {
 			logrus.Errorf("HTTP server error: %v", err)
 		}
 	}()
-	grpcListener, err := net.Listen("tcp", ":50051")
+	grpcListener, err := net.Listen("tcp", cfg.GRPCAddr)
 	if err != nil {
 		logrus.Fatalf("Failed to listen on gRPC: %v", err)
 	}
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(
+			metrics.UnaryServerInterceptor,
+			middleware.UnaryServerRequestID,
+			apperr.UnaryServerInterceptor,
+			middleware.UnaryServerRecover,
+		),
+	)
 	hotelv1.RegisterHotelServiceServer(grpcServer, hotelServer)
+	healthpb.RegisterHealthServer(grpcServer, health.NewGRPCServer(readiness, hotelv1.HotelService_ServiceDesc.ServiceName))
 	go func() {
-		logrus.Info("Starting hotel gRPC server on :50051")
+		logrus.Infof("Starting hotel gRPC server on %s", cfg.GRPCAddr)
 		if err := grpcServer.Serve(grpcListener); err != nil {
 			logrus.Errorf("gRPC server error: %v", err)
 		}
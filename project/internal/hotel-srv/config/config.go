@@ -0,0 +1,59 @@
+// Package config holds the settings of hotel-srv.
+package config
+
+import (
+	"errors"
+
+	"hotel-booking-system/internal/package/config"
+)
+
+type Config struct {
+	Database config.Database
+	Kafka    config.Kafka
+	// MigrateOnStart applies pending migrations before the service starts.
+	MigrateOnStart bool
+
+	HTTPAddr    string
+	GRPCAddr    string
+	CORSOrigins string
+
+	// Command holds the arguments after the flags, e.g. migrate up.
+	Command []string
+}
+
+func Default() *Config {
+	return &Config{
+		MigrateOnStart: true,
+		Database:       config.DefaultDatabase(),
+		Kafka:          config.DefaultKafka(),
+		HTTPAddr:       ":8081",
+		GRPCAddr:       ":50051",
+	}
+}
+
+// Load reads the hotel section of the config file, the environment and args
+// and validates the result.
+func Load(args []string) (*Config, error) {
+	cfg := Default()
+	l := config.New("hotel-srv")
+	l.Database(&cfg.Database, "hotel")
+	l.Bool(&cfg.MigrateOnStart, "migrate-on-start", "MIGRATE_ON_START", "apply pending migrations at startup")
+	l.Kafka(&cfg.Kafka)
+	l.String(&cfg.HTTPAddr, "http-addr", "HTTP_PORT", "HTTP listen address")
+	l.String(&cfg.GRPCAddr, "grpc-addr", "GRPC_PORT", "gRPC listen address")
+	l.String(&cfg.CORSOrigins, "cors-origins", "CORS_ORIGINS", "comma-separated allowed CORS origins")
+
+	if err := l.Load(args); err != nil {
+		return nil, err
+	}
+	cfg.Command = l.Args()
+	return cfg, cfg.Validate()
+}
+
+func (c *Config) Validate() error {
+	errs := []error{c.Database.Validate(), c.Kafka.Validate()}
+	if c.HTTPAddr == "" || c.GRPCAddr == "" {
+		errs = append(errs, errors.New("HTTP and gRPC addresses are required"))
+	}
+	return errors.Join(errs...)
+}
@@ -1,13 +1,19 @@
 package exceptions
 
-import "errors"
+import "hotel-booking-system/internal/package/apperr"
 
 var (
-    ErrInvalidHotelData  = errors.New("invalid hotel data")
-    ErrInvalidRoomData   = errors.New("invalid room data")
-    ErrHotelNotFound     = errors.New("hotel not found")
-    ErrRoomNotFound      = errors.New("room not found")
-    ErrRoomTypeNotFound  = errors.New("room type not found")
-    ErrRoomNotAvailable  = errors.New("room not available")
-    ErrInvalidPrice      = errors.New("invalid price")
-)
\ No newline at end of file
+	ErrInvalidHotelData  = apperr.New(apperr.InvalidArgument, "invalid hotel data")
+	ErrInvalidRoomData   = apperr.New(apperr.InvalidArgument, "invalid room data")
+	ErrHotelNotFound     = apperr.New(apperr.NotFound, "hotel not found")
+	ErrRoomNotFound      = apperr.New(apperr.NotFound, "room not found")
+	ErrRoomTypeNotFound  = apperr.New(apperr.NotFound, "room type not found")
+	ErrRoomNotAvailable  = apperr.New(apperr.FailedPrecondition, "room not available")
+	ErrInvalidPrice      = apperr.New(apperr.InvalidArgument, "invalid price")
+	ErrInvalidReviewData = apperr.New(apperr.InvalidArgument, "invalid review data")
+	ErrReviewNotFound    = apperr.New(apperr.NotFound, "review not found")
+	ErrReviewExists      = apperr.New(apperr.AlreadyExists, "review for this booking already exists")
+	ErrInvalidRequest    = apperr.New(apperr.InvalidArgument, "invalid request")
+	ErrInvalidJSON       = apperr.New(apperr.InvalidArgument, "invalid json")
+	ErrPermissionDenied  = apperr.New(apperr.PermissionDenied, "permission denied")
+)
@@ -0,0 +1,224 @@
+// Package memory implements the hotel-srv repository in memory, following
+// the semantics of the Postgres repository, so that storage can be tested
+// without a database.
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"hotel-booking-system/internal/hotel-srv/repository"
+)
+
+type roomType struct {
+	id      int
+	hotelID int
+	name    string
+	price   float64
+}
+
+type room struct {
+	id         int
+	roomTypeID int
+	number     string
+}
+
+// Repository is safe for concurrent use.
+type Repository struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	hotels    []repository.Hotel
+	roomTypes []roomType
+	rooms     []room
+	reviews   []repository.Review
+}
+
+func New() *Repository {
+	return &Repository{now: time.Now}
+}
+
+// AddRoomType adds a room type to a hotel and returns its ID. Room types are
+// seeded by migrations in Postgres, so the repository has no method for it.
+func (r *Repository) AddRoomType(hotelID int, name string, price float64) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := len(r.roomTypes) + 1
+	r.roomTypes = append(r.roomTypes, roomType{id: id, hotelID: hotelID, name: name, price: price})
+	return id
+}
+
+func (r *Repository) CreateHotel(ctx context.Context, hotel *repository.Hotel) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	hotel.ID = len(r.hotels) + 1
+	r.hotels = append(r.hotels, repository.Hotel{
+		ID:           hotel.ID,
+		Name:         hotel.Name,
+		Address:      hotel.Address,
+		ContactPhone: hotel.ContactPhone,
+	})
+	return nil
+}
+
+// GetAllHotels rates hotels by their published reviews.
+func (r *Repository) GetAllHotels(ctx context.Context) ([]repository.Hotel, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var hotels []repository.Hotel
+	for _, h := range r.hotels {
+		sum := 0
+		h.ReviewsCount = 0
+		for _, rv := range r.reviews {
+			if rv.HotelID == h.ID && rv.Status == repository.ReviewPublished {
+				sum += rv.Rating
+				h.ReviewsCount++
+			}
+		}
+		h.Rating = 0
+		if h.ReviewsCount > 0 {
+			h.Rating = float64(sum) / float64(h.ReviewsCount)
+		}
+		hotels = append(hotels, h)
+	}
+	return hotels, nil
+}
+
+func (r *Repository) GetRoomPriceInfo(ctx context.Context, hotelID, roomTypeID int) (float64, string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rt := r.roomType(hotelID, roomTypeID)
+	if rt == nil {
+		return 0, "", repository.ErrNotFound
+	}
+	return rt.price, "RUB", nil
+}
+
+func (r *Repository) GetHotelDetails(ctx context.Context, hotelID, roomTypeID int) (*repository.HotelDetails, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rt := r.roomType(hotelID, roomTypeID)
+	if rt == nil || hotelID < 1 || hotelID > len(r.hotels) {
+		return nil, repository.ErrNotFound
+	}
+	h := r.hotels[hotelID-1]
+	return &repository.HotelDetails{
+		Name:         h.Name,
+		Address:      h.Address,
+		ContactPhone: h.ContactPhone,
+		RoomTypeName: rt.name,
+	}, nil
+}
+
+// GetRoomIDsByHotelAndType, like the SQL query, only looks at the room type:
+// room type IDs are unique across hotels.
+func (r *Repository) GetRoomIDsByHotelAndType(ctx context.Context, hotelID, roomTypeID int) ([]int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ids []int
+	for _, rm := range r.rooms {
+		if rm.roomTypeID == roomTypeID {
+			ids = append(ids, rm.id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *Repository) UpdateRoomPrice(ctx context.Context, hotelID, roomTypeID int, price float64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rt := r.roomType(hotelID, roomTypeID)
+	if rt == nil {
+		return repository.ErrNotFound
+	}
+	rt.price = price
+	return nil
+}
+
+func (r *Repository) AddRoom(ctx context.Context, hotelID, roomTypeID int, roomNumber string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.roomType(hotelID, roomTypeID) == nil {
+		return 0, repository.ErrNotFound
+	}
+	id := len(r.rooms) + 1
+	r.rooms = append(r.rooms, room{id: id, roomTypeID: roomTypeID, number: roomNumber})
+	return id, nil
+}
+
+func (r *Repository) CreateReview(ctx context.Context, review *repository.Review) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rv := range r.reviews {
+		if rv.BookingID == review.BookingID {
+			return repository.ErrDuplicate
+		}
+	}
+	review.ID = len(r.reviews) + 1
+	review.Status = repository.ReviewPublished
+	review.CreatedAt = r.now()
+	r.reviews = append(r.reviews, repository.Review{
+		ID:        review.ID,
+		HotelID:   review.HotelID,
+		BookingID: review.BookingID,
+		UserID:    review.UserID,
+		Rating:    review.Rating,
+		Text:      review.Text,
+		Status:    review.Status,
+		CreatedAt: review.CreatedAt,
+	})
+	return nil
+}
+
+func (r *Repository) GetHotelReviews(ctx context.Context, hotelID int) ([]repository.Review, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var reviews []repository.Review
+	for _, rv := range r.reviews {
+		if rv.HotelID == hotelID && rv.Status == repository.ReviewPublished {
+			reviews = append(reviews, rv)
+		}
+	}
+	slices.SortStableFunc(reviews, func(a, b repository.Review) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	return reviews, nil
+}
+
+func (r *Repository) ReplyToReview(ctx context.Context, hotelID, reviewID int, reply string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rv := r.review(reviewID)
+	if rv == nil || rv.HotelID != hotelID {
+		return repository.ErrNotFound
+	}
+	repliedAt := r.now()
+	rv.Reply = &reply
+	rv.RepliedAt = &repliedAt
+	return nil
+}
+
+func (r *Repository) SetReviewStatus(ctx context.Context, reviewID int, status string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rv := r.review(reviewID)
+	if rv == nil {
+		return repository.ErrNotFound
+	}
+	rv.Status = status
+	return nil
+}
+
+func (r *Repository) roomType(hotelID, roomTypeID int) *roomType {
+	if roomTypeID < 1 || roomTypeID > len(r.roomTypes) || r.roomTypes[roomTypeID-1].hotelID != hotelID {
+		return nil
+	}
+	return &r.roomTypes[roomTypeID-1]
+}
+
+func (r *Repository) review(reviewID int) *repository.Review {
+	if reviewID < 1 || reviewID > len(r.reviews) {
+		return nil
+	}
+	return &r.reviews[reviewID-1]
+}
@@ -4,15 +4,64 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
+
+	"hotel-booking-system/internal/package/apperr"
+	"hotel-booking-system/internal/package/validate"
+
+	"github.com/lib/pq"
 )
 
-var ErrNotFound = errors.New("record not found")
+var (
+	ErrNotFound  = apperr.New(apperr.NotFound, "record not found")
+	ErrDuplicate = apperr.New(apperr.AlreadyExists, "record already exists")
+)
+
+// uniqueViolation is the PostgreSQL error code of a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+const (
+	ReviewPublished = "published"
+	ReviewHidden    = "hidden"
+)
 
 type Hotel struct {
-	ID           int    `json:"id"`
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	Address      string  `json:"address"`
+	ContactPhone string  `json:"contact_phone"`
+	Rating       float64 `json:"rating"`
+	ReviewsCount int     `json:"reviews_count"`
+}
+
+// Validate checks the hotel before it is stored.
+func (h Hotel) Validate() error {
+	return validate.Struct(
+		validate.Field("name", h.Name, validate.Required[string](), validate.MaxLen(255)),
+		validate.Field("address", h.Address, validate.Required[string](), validate.MaxLen(500)),
+		validate.Field("contact_phone", h.ContactPhone, validate.Required[string](), validate.Phone()),
+	)
+}
+
+type HotelDetails struct {
 	Name         string `json:"name"`
 	Address      string `json:"address"`
 	ContactPhone string `json:"contact_phone"`
+	RoomTypeName string `json:"room_type_name"`
+}
+
+type Review struct {
+	ID        int        `json:"id"`
+	HotelID   int        `json:"hotel_id"`
+	BookingID int        `json:"booking_id"`
+	UserID    int        `json:"user_id"`
+	Rating    int        `json:"rating"`
+	Text      string     `json:"text"`
+	Status    string     `json:"status"`
+	Reply     *string    `json:"reply,omitempty"`
+	RepliedAt *time.Time `json:"replied_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
 }
 
 type Repository struct {
@@ -29,7 +78,15 @@ func (r *Repository) CreateHotel(ctx context.Context, hotel *Hotel) error {
 }
 
 func (r *Repository) GetAllHotels(ctx context.Context) ([]Hotel, error) {
-	rows, err := r.db.QueryContext(ctx, `SELECT id, name, address, contact_phone FROM hotels`)
+	query := `
+		SELECT h.id, h.name, h.address, h.contact_phone,
+		       COALESCE(AVG(r.rating), 0), COUNT(r.id)
+		FROM hotels h
+		LEFT JOIN reviews r ON r.hotel_id = h.id AND r.status = 'published'
+		GROUP BY h.id
+		ORDER BY h.id
+	`
+	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +95,7 @@ func (r *Repository) GetAllHotels(ctx context.Context) ([]Hotel, error) {
 	var hotels []Hotel
 	for rows.Next() {
 		var h Hotel
-		if err := rows.Scan(&h.ID, &h.Name, &h.Address, &h.ContactPhone); err != nil {
+		if err := rows.Scan(&h.ID, &h.Name, &h.Address, &h.ContactPhone, &h.Rating, &h.ReviewsCount); err != nil {
 			return nil, err
 		}
 		hotels = append(hotels, h)
@@ -61,6 +118,24 @@ func (r *Repository) GetRoomPriceInfo(ctx context.Context, hotelID, roomTypeID i
 	return price, currency, err
 }
 
+func (r *Repository) GetHotelDetails(ctx context.Context, hotelID, roomTypeID int) (*HotelDetails, error) {
+	query := `
+		SELECT h.name, h.address, h.contact_phone, rt.type
+		FROM hotels h
+		JOIN room_types_in_hotels rt ON rt.hotel_id = h.id
+		WHERE h.id = $1 AND rt.id = $2
+	`
+	var d HotelDetails
+	err := r.db.QueryRowContext(ctx, query, hotelID, roomTypeID).Scan(&d.Name, &d.Address, &d.ContactPhone, &d.RoomTypeName)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
 func (r *Repository) GetRoomIDsByHotelAndType(ctx context.Context, hotelID, roomTypeID int) ([]int, error) {
 
 	query := `SELECT id FROM rooms WHERE room_type = $1`
@@ -80,3 +155,120 @@ func (r *Repository) GetRoomIDsByHotelAndType(ctx context.Context, hotelID, room
 	}
 	return ids, nil
 }
+
+func (r *Repository) UpdateRoomPrice(ctx context.Context, hotelID, roomTypeID int, price float64) error {
+	query := `
+		UPDATE room_types_in_hotels
+		SET price_per_night = $3
+		WHERE hotel_id = $1 AND id = $2
+	`
+	res, err := r.db.ExecContext(ctx, query, hotelID, roomTypeID, price)
+	if err != nil {
+		return err
+	}
+	return checkAffected(res)
+}
+
+// AddRoom adds a room to the room type and returns its ID, or ErrNotFound
+// when the hotel has no such room type.
+func (r *Repository) AddRoom(ctx context.Context, hotelID, roomTypeID int, roomNumber string) (int, error) {
+	query := `
+		INSERT INTO rooms (room_type, room_number)
+		SELECT id, $3 FROM room_types_in_hotels
+		WHERE hotel_id = $1 AND id = $2
+		RETURNING id
+	`
+	var id int
+	err := r.db.QueryRowContext(ctx, query, hotelID, roomTypeID, roomNumber).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound
+	}
+	return id, err
+}
+
+func (r *Repository) CreateReview(ctx context.Context, review *Review) error {
+	query := `
+		INSERT INTO reviews (hotel_id, booking_id, user_id, rating, text)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, status, created_at
+	`
+	err := r.db.QueryRowContext(ctx, query,
+		review.HotelID,
+		review.BookingID,
+		review.UserID,
+		review.Rating,
+		review.Text,
+	).Scan(&review.ID, &review.Status, &review.CreatedAt)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return ErrDuplicate
+	}
+	return err
+}
+
+func (r *Repository) GetHotelReviews(ctx context.Context, hotelID int) ([]Review, error) {
+	query := `
+		SELECT id, hotel_id, booking_id, user_id, rating, text, status, reply, replied_at, created_at
+		FROM reviews
+		WHERE hotel_id = $1 AND status = 'published'
+		ORDER BY created_at DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, hotelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []Review
+	for rows.Next() {
+		var rv Review
+		if err := rows.Scan(
+			&rv.ID,
+			&rv.HotelID,
+			&rv.BookingID,
+			&rv.UserID,
+			&rv.Rating,
+			&rv.Text,
+			&rv.Status,
+			&rv.Reply,
+			&rv.RepliedAt,
+			&rv.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, rv)
+	}
+	return reviews, nil
+}
+
+func (r *Repository) ReplyToReview(ctx context.Context, hotelID, reviewID int, reply string) error {
+	query := `
+		UPDATE reviews
+		SET reply = $3, replied_at = NOW()
+		WHERE id = $1 AND hotel_id = $2
+	`
+	res, err := r.db.ExecContext(ctx, query, reviewID, hotelID, reply)
+	if err != nil {
+		return err
+	}
+	return checkAffected(res)
+}
+
+func (r *Repository) SetReviewStatus(ctx context.Context, reviewID int, status string) error {
+	res, err := r.db.ExecContext(ctx, `UPDATE reviews SET status = $2 WHERE id = $1`, reviewID, status)
+	if err != nil {
+		return err
+	}
+	return checkAffected(res)
+}
+
+func checkAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
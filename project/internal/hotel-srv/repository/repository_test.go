@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"hotel-booking-system/internal/package/apperr"
+)
+
+func TestHotelValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		hotel  Hotel
+		fields []string
+	}{
+		{"valid", Hotel{Name: "Гранд", Address: "Москва, Тверская 1", ContactPhone: "+7 (495) 123-45-67"}, nil},
+		{"empty", Hotel{}, []string{"name", "address", "contact_phone"}},
+		{"malformed phone", Hotel{Name: "Гранд", Address: "Москва", ContactPhone: "call us"}, []string{"contact_phone"}},
+		{"long name", Hotel{Name: strings.Repeat("x", 256), Address: "Москва", ContactPhone: "84951234567"}, []string{"name"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, f := range apperr.FieldsOf(tt.hotel.Validate()) {
+				got = append(got, f.Field)
+			}
+			if !slices.Equal(got, tt.fields) {
+				t.Errorf("invalid fields %v, want %v", got, tt.fields)
+			}
+		})
+	}
+}
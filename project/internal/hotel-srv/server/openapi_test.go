@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"hotel-booking-system/internal/hotel-srv/repository"
+	"hotel-booking-system/internal/package/apperr"
+	"hotel-booking-system/internal/package/health"
+	"hotel-booking-system/package/api/openapi"
+	"hotel-booking-system/package/api/openapi/hotelclient"
+	api "hotel-booking-system/package/api/stable"
+)
+
+func TestRoutesMatchOpenAPI(t *testing.T) {
+	server := NewHotelServer(nil, nil)
+	server.SetServer()
+
+	var routes []string
+	for _, rt := range server.routes() {
+		routes = append(routes, rt.pattern)
+	}
+	if err := openapi.CheckRoutes(openapi.HotelSpec, server.Mux, routes); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSchemasMatchTypes(t *testing.T) {
+	tests := []struct {
+		schema string
+		value  any
+	}{
+		{"Hotel", repository.Hotel{}},
+		{"Review", repository.Review{}},
+		{"ReviewReplyRequest", api.ReviewReplyRequest{}},
+		{"ReviewStatusRequest", api.ReviewStatusRequest{}},
+		{"RoomPriceRequest", api.RoomPriceRequest{}},
+		{"CreateRoomRequest", api.CreateRoomRequest{}},
+		{"RoomIDResponse", api.CreateRoomResponse{}},
+		{"Problem", apperr.Problem{}},
+		{"FieldError", apperr.FieldError{}},
+		{"Readiness", health.Report{}},
+		{"DependencyStatus", health.Result{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schema, func(t *testing.T) {
+			if err := openapi.CheckSchema(openapi.HotelSpec, tt.schema, tt.value); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestGeneratedClient(t *testing.T) {
+	server := NewHotelServer(nil, nil)
+	server.SetServer()
+	ts := httptest.NewServer(server.Mux)
+	defer ts.Close()
+
+	client, err := hotelclient.NewClientWithResponses(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	health, err := client.HealthWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if health.StatusCode() != http.StatusOK {
+		t.Errorf("health status = %d", health.StatusCode())
+	}
+
+	doc, err := client.GetOpenAPIWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.JSON200 == nil || (*doc.JSON200)["openapi"] != "3.0.3" {
+		t.Errorf("unexpected openapi document: %s", doc.Body)
+	}
+}
@@ -3,63 +3,120 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"hotel-booking-system/internal/hotel-srv/exceptions"
 	"hotel-booking-system/internal/hotel-srv/repository"
 	"hotel-booking-system/internal/hotel-srv/stg"
+	"hotel-booking-system/internal/package/apivalidate"
+	"hotel-booking-system/internal/package/apperr"
+	"hotel-booking-system/internal/package/health"
+	"hotel-booking-system/internal/package/metrics"
+	"hotel-booking-system/internal/package/middleware"
+	"hotel-booking-system/package/api/openapi"
+	api "hotel-booking-system/package/api/stable"
 	hotelv1 "hotel-booking-system/package/proto/fast/stable"
 
 	"github.com/sirupsen/logrus"
 )
 
 type HotelServer struct {
-	Src *stg.Storage
-	Mux *http.ServeMux
+	Src       *stg.Storage
+	Mux       *http.ServeMux
+	Readiness *health.Checker
 	hotelv1.UnimplementedHotelServiceServer
 }
 
-func NewHotelServer(storage *stg.Storage) *HotelServer {
+type route struct {
+	pattern string
+	handler http.HandlerFunc
+}
+
+func NewHotelServer(storage *stg.Storage, readiness *health.Checker) *HotelServer {
 	return &HotelServer{
-		Src: storage,
-		Mux: http.NewServeMux(),
+		Src:       storage,
+		Mux:       http.NewServeMux(),
+		Readiness: readiness,
 	}
 }
 
+// requestTimeout bounds every HTTP request; hotel routes only touch the
+// database.
+const requestTimeout = 10 * time.Second
+
 func (server *HotelServer) SetServer() {
-	server.Mux.HandleFunc("GET /api/hotels", server.GetHotelsHandler)
-	server.Mux.HandleFunc("POST /api/hotels", server.CreateHotelHandler)
+	for _, rt := range server.routes() {
+		server.Mux.Handle(rt.pattern, middleware.Timeout(requestTimeout)(rt.handler))
+	}
+}
 
-	server.Mux.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
+// routes lists every HTTP endpoint of the service. Tests check it against
+// the OpenAPI spec, so new routes have to be documented there.
+func (server *HotelServer) routes() []route {
+	return []route{
+		{"GET /api/hotels", server.GetHotelsHandler},
+		{"POST /api/hotels", server.CreateHotelHandler},
+		{"GET /api/hotels/{hotel_id}/reviews", server.GetHotelReviewsHandler},
+		{"POST /api/hotels/{hotel_id}/reviews/{review_id}/reply", server.ReplyToReviewHandler},
+		{"PUT /api/reviews/{review_id}/status", server.SetReviewStatusHandler},
+		{"PUT /api/hotels/{hotel_id}/room_types/{room_type_id}/price", server.UpdateRoomPriceHandler},
+		{"POST /api/hotels/{hotel_id}/room_types/{room_type_id}/rooms", server.CreateRoomHandler},
+		{"GET /health", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}},
+		{"GET /ready", server.Readiness.ServeHTTP},
+		{"GET /openapi.json", openapi.Handler(openapi.HotelSpec)},
+		{"GET /metrics", metrics.Handler().ServeHTTP},
+	}
 }
 
-func writeInvalidJSON(w http.ResponseWriter, status int) {
-	w.WriteHeader(status)
-	json.NewEncoder(w).Encode(struct {
-		Error string `json:"error"`
-	}{
-		Error: "invalid json",
-	})
+// decodeError reports a body that could not be decoded, telling bodies cut
+// off by the size limit apart from malformed JSON.
+func decodeError(err error) error {
+	if middleware.IsBodyTooLarge(err) {
+		return middleware.ErrBodyTooLarge
+	}
+	return exceptions.ErrInvalidJSON
 }
 
-func writeServerError(w http.ResponseWriter, err error) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusInternalServerError)
-	json.NewEncoder(w).Encode(struct {
-		Error string `json:"error"`
-	}{
-		Error: err.Error(),
-	})
-	logrus.Error(err)
+func invalidParam(name string) error {
+	return fmt.Errorf("%w: invalid %s", exceptions.ErrInvalidRequest, name)
+}
+
+// Roles of the X-Actor header, "<role>:<id>", which the gateway sets for
+// authenticated callers. The id of a manager is the hotel they manage.
+const (
+	roleAdmin   = "admin"
+	roleManager = "manager"
+)
+
+// requireManager lets through admins and the managers of hotelID.
+func requireManager(r *http.Request, hotelID int) error {
+	role, id, _ := strings.Cut(r.Header.Get("X-Actor"), ":")
+	if role == roleAdmin || role == roleManager && id == strconv.Itoa(hotelID) {
+		return nil
+	}
+	return fmt.Errorf("%w: only managers of hotel %d may do this", exceptions.ErrPermissionDenied, hotelID)
+}
+
+// requireAdmin lets through admins only.
+func requireAdmin(r *http.Request) error {
+	if role, _, _ := strings.Cut(r.Header.Get("X-Actor"), ":"); role == roleAdmin {
+		return nil
+	}
+	return fmt.Errorf("%w: only admins may do this", exceptions.ErrPermissionDenied)
 }
 
 func (server *HotelServer) GetHotelsHandler(w http.ResponseWriter, r *http.Request) {
-	logrus.Info("GetHotels request")
+	middleware.Logger(r.Context()).Info("GetHotels request")
 
 	hotels, err := server.Src.GetAllHotels(r.Context())
 	if err != nil {
-		writeServerError(w, err)
+		apperr.WriteHTTP(w, err)
 		return
 	}
 
@@ -69,39 +126,181 @@ func (server *HotelServer) GetHotelsHandler(w http.ResponseWriter, r *http.Reque
 }
 
 func (server *HotelServer) CreateHotelHandler(w http.ResponseWriter, r *http.Request) {
-	logrus.Info("CreateHotel request")
+	middleware.Logger(r.Context()).Info("CreateHotel request")
 
 	var hotel repository.Hotel
 
 	if err := json.NewDecoder(r.Body).Decode(&hotel); err != nil {
-		writeInvalidJSON(w, http.StatusBadRequest)
+		apperr.WriteHTTP(w, decodeError(err))
 		return
 	}
 
-	if hotel.Name == "" || hotel.Address == "" || hotel.ContactPhone == "" {
-		http.Error(w, "name, address and contact_phone are required", http.StatusBadRequest)
+	if err := server.Src.CreateHotel(r.Context(), &hotel); err != nil {
+		apperr.WriteHTTP(w, err)
 		return
 	}
 
-	if err := server.Src.CreateHotel(r.Context(), &hotel); err != nil {
-		writeServerError(w, err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(hotel)
+}
+
+func (server *HotelServer) GetHotelReviewsHandler(w http.ResponseWriter, r *http.Request) {
+	hotelID, err := strconv.Atoi(r.PathValue("hotel_id"))
+	if err != nil {
+		apperr.WriteHTTP(w, invalidParam("hotel_id"))
+		return
+	}
+
+	reviews, err := server.Src.GetHotelReviews(r.Context(), hotelID)
+	if err != nil {
+		apperr.WriteHTTP(w, err)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(hotel)
+	json.NewEncoder(w).Encode(reviews)
+}
+
+func (server *HotelServer) ReplyToReviewHandler(w http.ResponseWriter, r *http.Request) {
+	hotelID, err := strconv.Atoi(r.PathValue("hotel_id"))
+	if err != nil {
+		apperr.WriteHTTP(w, invalidParam("hotel_id"))
+		return
+	}
+	reviewID, err := strconv.Atoi(r.PathValue("review_id"))
+	if err != nil {
+		apperr.WriteHTTP(w, invalidParam("review_id"))
+		return
+	}
+	if err := requireManager(r, hotelID); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	var req api.ReviewReplyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apperr.WriteHTTP(w, decodeError(err))
+		return
+	}
+
+	if err := server.Src.ReplyToReview(r.Context(), hotelID, reviewID, req.Reply); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *HotelServer) SetReviewStatusHandler(w http.ResponseWriter, r *http.Request) {
+	reviewID, err := strconv.Atoi(r.PathValue("review_id"))
+	if err != nil {
+		apperr.WriteHTTP(w, invalidParam("review_id"))
+		return
+	}
+	if err := requireAdmin(r); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	var req api.ReviewStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apperr.WriteHTTP(w, decodeError(err))
+		return
+	}
+
+	if err := server.Src.SetReviewStatus(r.Context(), reviewID, req.Status); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// roomTypePath parses the hotel and room type IDs of a room type route.
+func roomTypePath(r *http.Request) (hotelID, roomTypeID int, err error) {
+	hotelID, err = strconv.Atoi(r.PathValue("hotel_id"))
+	if err != nil {
+		return 0, 0, invalidParam("hotel_id")
+	}
+	roomTypeID, err = strconv.Atoi(r.PathValue("room_type_id"))
+	if err != nil {
+		return 0, 0, invalidParam("room_type_id")
+	}
+	return hotelID, roomTypeID, nil
+}
+
+func (server *HotelServer) UpdateRoomPriceHandler(w http.ResponseWriter, r *http.Request) {
+	hotelID, roomTypeID, err := roomTypePath(r)
+	if err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+	if err := requireManager(r, hotelID); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	var req api.RoomPriceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apperr.WriteHTTP(w, decodeError(err))
+		return
+	}
+	if err := apivalidate.RoomPriceRequest(req); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	if err := server.Src.UpdateRoomPrice(r.Context(), hotelID, roomTypeID, req.Price); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *HotelServer) CreateRoomHandler(w http.ResponseWriter, r *http.Request) {
+	hotelID, roomTypeID, err := roomTypePath(r)
+	if err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+	if err := requireManager(r, hotelID); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	var req api.CreateRoomRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apperr.WriteHTTP(w, decodeError(err))
+		return
+	}
+	if err := apivalidate.CreateRoomRequest(req); err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	roomID, err := server.Src.AddRoom(r.Context(), hotelID, roomTypeID, req.RoomNumber)
+	if err != nil {
+		apperr.WriteHTTP(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(api.CreateRoomResponse{RoomID: roomID})
 }
 
 func (server *HotelServer) GetRoomPrice(ctx context.Context, req *hotelv1.GetRoomPriceRequest) (*hotelv1.GetRoomPriceResponse, error) {
-	logrus.WithFields(logrus.Fields{
+	middleware.Logger(ctx).WithFields(logrus.Fields{
 		"hotel_id":     req.HotelId,
 		"room_type_id": req.RoomTypeId,
 	}).Info("GetRoomPrice gRPC request")
 
 	price, currency, err := server.Src.GetRoomPriceInfo(ctx, int(req.HotelId), int(req.RoomTypeId))
 	if err != nil {
-		logrus.WithError(err).Error("Failed to get room price")
+		middleware.Logger(ctx).WithError(err).Error("Failed to get room price")
 		return nil, err
 	}
 
@@ -112,14 +311,14 @@ func (server *HotelServer) GetRoomPrice(ctx context.Context, req *hotelv1.GetRoo
 }
 
 func (server *HotelServer) GetRoomsID(ctx context.Context, req *hotelv1.GetRoomsIDRequest) (*hotelv1.GetRoomsIDResponse, error) {
-	logrus.WithFields(logrus.Fields{
+	middleware.Logger(ctx).WithFields(logrus.Fields{
 		"hotel_id":     req.HotelId,
 		"room_type_id": req.RoomTypeId,
 	}).Info("GetRoomsID gRPC request")
 
 	ids, err := server.Src.GetRoomIDsByHotelAndType(ctx, int(req.HotelId), int(req.RoomTypeId))
 	if err != nil {
-		logrus.WithError(err).Error("Failed to get room IDs")
+		middleware.Logger(ctx).WithError(err).Error("Failed to get room IDs")
 		return nil, err
 	}
 
@@ -132,3 +331,46 @@ func (server *HotelServer) GetRoomsID(ctx context.Context, req *hotelv1.GetRooms
 		RoomIds: protoIDs,
 	}, nil
 }
+
+func (server *HotelServer) GetHotelDetails(ctx context.Context, req *hotelv1.GetHotelDetailsRequest) (*hotelv1.GetHotelDetailsResponse, error) {
+	middleware.Logger(ctx).WithFields(logrus.Fields{
+		"hotel_id":     req.HotelId,
+		"room_type_id": req.RoomTypeId,
+	}).Info("GetHotelDetails gRPC request")
+
+	details, err := server.Src.GetHotelDetails(ctx, int(req.HotelId), int(req.RoomTypeId))
+	if err != nil {
+		middleware.Logger(ctx).WithError(err).Error("Failed to get hotel details")
+		return nil, err
+	}
+
+	return &hotelv1.GetHotelDetailsResponse{
+		HotelName:    details.Name,
+		Address:      details.Address,
+		ContactPhone: details.ContactPhone,
+		RoomTypeName: details.RoomTypeName,
+	}, nil
+}
+
+func (server *HotelServer) CreateReview(ctx context.Context, req *hotelv1.CreateReviewRequest) (*hotelv1.CreateReviewResponse, error) {
+	middleware.Logger(ctx).WithFields(logrus.Fields{
+		"hotel_id":   req.HotelId,
+		"booking_id": req.BookingId,
+	}).Info("CreateReview gRPC request")
+
+	review := repository.Review{
+		HotelID:   int(req.HotelId),
+		BookingID: int(req.BookingId),
+		UserID:    int(req.UserId),
+		Rating:    int(req.Rating),
+		Text:      req.Text,
+	}
+	if err := server.Src.CreateReview(ctx, &review); err != nil {
+		middleware.Logger(ctx).WithError(err).Error("Failed to create review")
+		return nil, err
+	}
+
+	return &hotelv1.CreateReviewResponse{
+		ReviewId: int32(review.ID),
+	}, nil
+}
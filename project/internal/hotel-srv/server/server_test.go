@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"hotel-booking-system/internal/hotel-srv/repository"
+	"hotel-booking-system/internal/hotel-srv/repository/memory"
+	"hotel-booking-system/internal/hotel-srv/stg"
+	kafkamem "hotel-booking-system/internal/kafka/memory"
+	"hotel-booking-system/internal/package/health"
+	"hotel-booking-system/package/api/openapi/hotelclient"
+)
+
+func TestManagementRequiresRole(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	storage := stg.NewStorage(repo, kafkamem.NewBus())
+	hotel := &repository.Hotel{Name: "Гранд", Address: "Москва, Тверская 1", ContactPhone: "+7 (495) 123-45-67"}
+	if err := storage.CreateHotel(ctx, hotel); err != nil {
+		t.Fatal(err)
+	}
+	roomType := repo.AddRoomType(hotel.ID, "Double", 5000)
+	if err := storage.CreateReview(ctx, &repository.Review{HotelID: hotel.ID, BookingID: 1, UserID: 1, Rating: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	server := NewHotelServer(storage, health.NewChecker())
+	server.SetServer()
+	ts := httptest.NewServer(server.Mux)
+	defer ts.Close()
+	client, err := hotelclient.NewClientWithResponses(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := map[string]func(actor *string) (int, error){
+		"reply": func(actor *string) (int, error) {
+			resp, err := client.ReplyToReviewWithResponse(ctx, hotel.ID, 1, &hotelclient.ReplyToReviewParams{XActor: actor},
+				hotelclient.ReviewReplyRequest{Reply: "Спасибо!"})
+			if err != nil {
+				return 0, err
+			}
+			return resp.StatusCode(), nil
+		},
+		"moderate": func(actor *string) (int, error) {
+			resp, err := client.SetReviewStatusWithResponse(ctx, 1, &hotelclient.SetReviewStatusParams{XActor: actor},
+				hotelclient.ReviewStatusRequest{Status: repository.ReviewHidden})
+			if err != nil {
+				return 0, err
+			}
+			return resp.StatusCode(), nil
+		},
+		"price": func(actor *string) (int, error) {
+			resp, err := client.UpdateRoomPriceWithResponse(ctx, hotel.ID, roomType, &hotelclient.UpdateRoomPriceParams{XActor: actor},
+				hotelclient.RoomPriceRequest{Price: 6500})
+			if err != nil {
+				return 0, err
+			}
+			return resp.StatusCode(), nil
+		},
+		"room": func(actor *string) (int, error) {
+			resp, err := client.CreateRoomWithResponse(ctx, hotel.ID, roomType, &hotelclient.CreateRoomParams{XActor: actor},
+				hotelclient.CreateRoomRequest{RoomNumber: "101"})
+			if err != nil {
+				return 0, err
+			}
+			return resp.StatusCode(), nil
+		},
+	}
+
+	actor := func(s string) *string { return &s }
+	tests := []struct {
+		call  string
+		actor *string
+		want  int
+	}{
+		{"reply", nil, http.StatusForbidden},
+		{"reply", actor("guest:1"), http.StatusForbidden},
+		{"reply", actor("manager:2"), http.StatusForbidden},
+		{"reply", actor("manager:1"), http.StatusOK},
+		{"moderate", actor("manager:1"), http.StatusForbidden},
+		{"moderate", actor("admin:anna"), http.StatusOK},
+		{"price", nil, http.StatusForbidden},
+		{"price", actor("manager:2"), http.StatusForbidden},
+		{"price", actor("manager:1"), http.StatusNoContent},
+		{"room", actor("guest:1"), http.StatusForbidden},
+		{"room", actor("admin:anna"), http.StatusCreated},
+	}
+	for _, tt := range tests {
+		got, err := calls[tt.call](tt.actor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			name := "no actor"
+			if tt.actor != nil {
+				name = *tt.actor
+			}
+			t.Errorf("%s as %s: status %d, want %d", tt.call, name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package stg
+
+import (
+	"context"
+	"strconv"
+
+	"hotel-booking-system/internal/package/requestctx"
+	"hotel-booking-system/package/events"
+
+	"github.com/sirupsen/logrus"
+)
+
+// producerName identifies hotel-srv in event envelopes.
+const producerName = "hotel-srv"
+
+// publish sends event in the background once the change is committed, keyed
+// by hotel so that the events of one hotel stay in order. Delivery is best
+// effort: consumers cache hotel data for a bounded time, so a lost event only
+// delays the update, and a Kafka outage must not hold up the request.
+func (s *Storage) publish(ctx context.Context, hotelID int, event events.Event) {
+	var traceContext map[string]string
+	if requestID := requestctx.RequestID(ctx); requestID != "" {
+		traceContext = map[string]string{"x-request-id": requestID}
+	}
+	env, err := events.NewEnvelope(producerName, event, traceContext)
+	if err != nil {
+		logrus.Errorf("Failed to build %s event: %v", event.EventType(), err)
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := s.producer.ProduceEnvelope(ctx, env, event.EventType(), strconv.Itoa(hotelID)); err != nil {
+			logrus.Errorf("Failed to publish %s event %s: %v", event.EventType(), env.EventID, err)
+		}
+	}()
+}
@@ -2,20 +2,54 @@ package stg
 
 import (
 	"context"
+	"errors"
+	"fmt"
+
+	"hotel-booking-system/internal/hotel-srv/exceptions"
 	"hotel-booking-system/internal/hotel-srv/repository"
+	"hotel-booking-system/package/events"
+
+	"github.com/sirupsen/logrus"
 )
 
+// Repository is the hotel persistence Storage works with.
+// *repository.Repository implements it on Postgres.
+type Repository interface {
+	CreateHotel(ctx context.Context, hotel *repository.Hotel) error
+	GetAllHotels(ctx context.Context) ([]repository.Hotel, error)
+	GetRoomPriceInfo(ctx context.Context, hotelID, roomTypeID int) (float64, string, error)
+	GetHotelDetails(ctx context.Context, hotelID, roomTypeID int) (*repository.HotelDetails, error)
+	GetRoomIDsByHotelAndType(ctx context.Context, hotelID, roomTypeID int) ([]int, error)
+	UpdateRoomPrice(ctx context.Context, hotelID, roomTypeID int, price float64) error
+	AddRoom(ctx context.Context, hotelID, roomTypeID int, roomNumber string) (int, error)
+	CreateReview(ctx context.Context, review *repository.Review) error
+	GetHotelReviews(ctx context.Context, hotelID int) ([]repository.Review, error)
+	ReplyToReview(ctx context.Context, hotelID, reviewID int, reply string) error
+	SetReviewStatus(ctx context.Context, reviewID int, status string) error
+}
+
+// Publisher delivers events to the message broker; *kafka.Producer
+// implements it.
+type Publisher interface {
+	ProduceEnvelope(ctx context.Context, env *events.Envelope, topic, key string) error
+}
+
 type Storage struct {
-	repo *repository.Repository
+	repo     Repository
+	producer Publisher
 }
 
-func NewStorage(repo *repository.Repository) *Storage {
+func NewStorage(repo Repository, producer Publisher) *Storage {
 	return &Storage{
-		repo: repo,
+		repo:     repo,
+		producer: producer,
 	}
 }
 
 func (s *Storage) CreateHotel(ctx context.Context, hotel *repository.Hotel) error {
+	if err := hotel.Validate(); err != nil {
+		return err
+	}
 	return s.repo.CreateHotel(ctx, hotel)
 }
 
@@ -24,9 +58,100 @@ func (s *Storage) GetAllHotels(ctx context.Context) ([]repository.Hotel, error)
 }
 
 func (s *Storage) GetRoomPriceInfo(ctx context.Context, hotelID, roomTypeID int) (float64, string, error) {
-	return s.repo.GetRoomPriceInfo(ctx, hotelID, roomTypeID)
+	price, currency, err := s.repo.GetRoomPriceInfo(ctx, hotelID, roomTypeID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return 0, "", roomTypeNotFound(hotelID, roomTypeID)
+	}
+	return price, currency, err
+}
+
+func (s *Storage) GetHotelDetails(ctx context.Context, hotelID, roomTypeID int) (*repository.HotelDetails, error) {
+	details, err := s.repo.GetHotelDetails(ctx, hotelID, roomTypeID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, roomTypeNotFound(hotelID, roomTypeID)
+	}
+	return details, err
 }
 
 func (s *Storage) GetRoomIDsByHotelAndType(ctx context.Context, hotelID, roomTypeID int) ([]int, error) {
 	return s.repo.GetRoomIDsByHotelAndType(ctx, hotelID, roomTypeID)
 }
+
+func (s *Storage) UpdateRoomPrice(ctx context.Context, hotelID, roomTypeID int, price float64) error {
+	err := s.repo.UpdateRoomPrice(ctx, hotelID, roomTypeID, price)
+	if errors.Is(err, repository.ErrNotFound) {
+		return roomTypeNotFound(hotelID, roomTypeID)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.publish(ctx, hotelID, events.RoomPriceChangedEvent{
+		HotelID:    hotelID,
+		RoomTypeID: roomTypeID,
+		Price:      price,
+	})
+	return nil
+}
+
+func (s *Storage) AddRoom(ctx context.Context, hotelID, roomTypeID int, roomNumber string) (int, error) {
+	roomID, err := s.repo.AddRoom(ctx, hotelID, roomTypeID, roomNumber)
+	if errors.Is(err, repository.ErrNotFound) {
+		return 0, roomTypeNotFound(hotelID, roomTypeID)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	roomIDs, err := s.repo.GetRoomIDsByHotelAndType(ctx, hotelID, roomTypeID)
+	if err != nil {
+		logrus.Errorf("Room %d added, but listing rooms of type %d failed: %v", roomID, roomTypeID, err)
+		return roomID, nil
+	}
+	s.publish(ctx, hotelID, events.RoomsChangedEvent{
+		HotelID:    hotelID,
+		RoomTypeID: roomTypeID,
+		RoomIDs:    roomIDs,
+	})
+	return roomID, nil
+}
+
+func (s *Storage) CreateReview(ctx context.Context, review *repository.Review) error {
+	if review.Rating < 1 || review.Rating > 5 {
+		return fmt.Errorf("%w: rating must be between 1 and 5", exceptions.ErrInvalidReviewData)
+	}
+	err := s.repo.CreateReview(ctx, review)
+	if errors.Is(err, repository.ErrDuplicate) {
+		return fmt.Errorf("%w: booking %d", exceptions.ErrReviewExists, review.BookingID)
+	}
+	return err
+}
+
+func (s *Storage) GetHotelReviews(ctx context.Context, hotelID int) ([]repository.Review, error) {
+	return s.repo.GetHotelReviews(ctx, hotelID)
+}
+
+func (s *Storage) ReplyToReview(ctx context.Context, hotelID, reviewID int, reply string) error {
+	if reply == "" {
+		return fmt.Errorf("%w: reply is required", exceptions.ErrInvalidReviewData)
+	}
+	return reviewNotFound(s.repo.ReplyToReview(ctx, hotelID, reviewID, reply), reviewID)
+}
+
+func (s *Storage) SetReviewStatus(ctx context.Context, reviewID int, status string) error {
+	if status != repository.ReviewPublished && status != repository.ReviewHidden {
+		return fmt.Errorf("%w: unknown status %q", exceptions.ErrInvalidReviewData, status)
+	}
+	return reviewNotFound(s.repo.SetReviewStatus(ctx, reviewID, status), reviewID)
+}
+
+func roomTypeNotFound(hotelID, roomTypeID int) error {
+	return fmt.Errorf("%w: hotel %d has no room type %d", exceptions.ErrRoomTypeNotFound, hotelID, roomTypeID)
+}
+
+func reviewNotFound(err error, reviewID int) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("%w: %d", exceptions.ErrReviewNotFound, reviewID)
+	}
+	return err
+}
@@ -0,0 +1,185 @@
+package stg
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"hotel-booking-system/internal/hotel-srv/exceptions"
+	"hotel-booking-system/internal/hotel-srv/repository"
+	"hotel-booking-system/internal/hotel-srv/repository/memory"
+	kafkamem "hotel-booking-system/internal/kafka/memory"
+	"hotel-booking-system/package/events"
+)
+
+func newTestStorage(t *testing.T) (*Storage, *memory.Repository, *kafkamem.Bus) {
+	t.Helper()
+	repo := memory.New()
+	bus := kafkamem.NewBus()
+	s := NewStorage(repo, bus)
+
+	hotel := &repository.Hotel{Name: "Гранд", Address: "Москва, Тверская 1", ContactPhone: "+7 (495) 123-45-67"}
+	if err := s.CreateHotel(context.Background(), hotel); err != nil {
+		t.Fatal(err)
+	}
+	repo.AddRoomType(hotel.ID, "Double", 5000)
+	return s, repo, bus
+}
+
+// published waits for the events that publish sends in the background.
+func published(t *testing.T, bus *kafkamem.Bus, topic string, want int) []kafkamem.Message {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		messages := bus.Messages(topic)
+		if len(messages) >= want || time.Now().After(deadline) {
+			return messages
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestUpdateRoomPrice(t *testing.T) {
+	s, _, bus := newTestStorage(t)
+	ctx := context.Background()
+
+	if err := s.UpdateRoomPrice(ctx, 1, 1, 6500); err != nil {
+		t.Fatal(err)
+	}
+	price, currency, err := s.GetRoomPriceInfo(ctx, 1, 1)
+	if err != nil || price != 6500 || currency != "RUB" {
+		t.Errorf("price %.2f %s, %v", price, currency, err)
+	}
+
+	messages := published(t, bus, events.TypeRoomPriceChanged, 1)
+	if len(messages) != 1 || messages[0].Key != "1" {
+		t.Fatalf("published %+v", messages)
+	}
+	event, err := messages[0].Event()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e, ok := event.(*events.RoomPriceChangedEvent); !ok || e.RoomTypeID != 1 || e.Price != 6500 {
+		t.Errorf("event %+v", event)
+	}
+
+	if err := s.UpdateRoomPrice(ctx, 2, 1, 100); !errors.Is(err, exceptions.ErrRoomTypeNotFound) {
+		t.Errorf("room type of another hotel: %v", err)
+	}
+	if _, _, err := s.GetRoomPriceInfo(ctx, 1, 9); !errors.Is(err, exceptions.ErrRoomTypeNotFound) {
+		t.Errorf("unknown room type: %v", err)
+	}
+}
+
+func TestAddRoom(t *testing.T) {
+	s, _, bus := newTestStorage(t)
+	ctx := context.Background()
+
+	for _, number := range []string{"101", "102"} {
+		if _, err := s.AddRoom(ctx, 1, 1, number); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ids, err := s.GetRoomIDsByHotelAndType(ctx, 1, 1)
+	if err != nil || !slices.Equal(ids, []int{1, 2}) {
+		t.Errorf("rooms %v, %v", ids, err)
+	}
+
+	messages := published(t, bus, events.TypeRoomsChanged, 2)
+	if len(messages) != 2 {
+		t.Fatalf("published %d events, want 2", len(messages))
+	}
+	// Publishing runs in the background, so the two events may arrive in
+	// either order; the one listing both rooms must be there.
+	var lists [][]int
+	for _, m := range messages {
+		event, err := m.Event()
+		if err != nil {
+			t.Fatal(err)
+		}
+		lists = append(lists, event.(*events.RoomsChangedEvent).RoomIDs)
+	}
+	if !slices.ContainsFunc(lists, func(l []int) bool { return slices.Equal(l, []int{1, 2}) }) {
+		t.Errorf("room lists %v", lists)
+	}
+
+	if _, err := s.AddRoom(ctx, 1, 9, "901"); !errors.Is(err, exceptions.ErrRoomTypeNotFound) {
+		t.Errorf("unknown room type: %v", err)
+	}
+}
+
+func TestGetHotelDetails(t *testing.T) {
+	s, _, _ := newTestStorage(t)
+	details, err := s.GetHotelDetails(context.Background(), 1, 1)
+	if err != nil || details.Name != "Гранд" || details.RoomTypeName != "Double" {
+		t.Errorf("details %+v, %v", details, err)
+	}
+	if _, err := s.GetHotelDetails(context.Background(), 1, 2); !errors.Is(err, exceptions.ErrRoomTypeNotFound) {
+		t.Errorf("unknown room type: %v", err)
+	}
+}
+
+func TestCreateHotelInvalid(t *testing.T) {
+	s, _, _ := newTestStorage(t)
+	if err := s.CreateHotel(context.Background(), &repository.Hotel{Name: "Гранд"}); err == nil {
+		t.Error("hotel without address and phone accepted")
+	}
+	hotels, _ := s.GetAllHotels(context.Background())
+	if len(hotels) != 1 {
+		t.Errorf("%d hotels stored, want 1", len(hotels))
+	}
+}
+
+func TestReviews(t *testing.T) {
+	s, _, _ := newTestStorage(t)
+	ctx := context.Background()
+
+	// Review 1 for booking 1 rates 5, review 2 for booking 2 rates 2.
+	for i, rating := range []int{5, 2} {
+		if err := s.CreateReview(ctx, &repository.Review{HotelID: 1, BookingID: i + 1, UserID: 1, Rating: rating}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.CreateReview(ctx, &repository.Review{HotelID: 1, BookingID: 1, UserID: 1, Rating: 4}); !errors.Is(err, exceptions.ErrReviewExists) {
+		t.Errorf("second review of a booking: %v", err)
+	}
+	if err := s.CreateReview(ctx, &repository.Review{HotelID: 1, BookingID: 3, UserID: 1, Rating: 0}); !errors.Is(err, exceptions.ErrInvalidReviewData) {
+		t.Errorf("rating 0: %v", err)
+	}
+
+	hotels, err := s.GetAllHotels(ctx)
+	if err != nil || hotels[0].Rating != 3.5 || hotels[0].ReviewsCount != 2 {
+		t.Fatalf("hotels %+v, %v", hotels, err)
+	}
+
+	if err := s.ReplyToReview(ctx, 1, 1, "Спасибо!"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ReplyToReview(ctx, 2, 1, "Чужой отзыв"); !errors.Is(err, exceptions.ErrReviewNotFound) {
+		t.Errorf("reply from another hotel: %v", err)
+	}
+	if err := s.ReplyToReview(ctx, 1, 1, ""); !errors.Is(err, exceptions.ErrInvalidReviewData) {
+		t.Errorf("empty reply: %v", err)
+	}
+
+	if err := s.SetReviewStatus(ctx, 2, repository.ReviewHidden); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetReviewStatus(ctx, 2, "deleted"); !errors.Is(err, exceptions.ErrInvalidReviewData) {
+		t.Errorf("unknown status: %v", err)
+	}
+	if err := s.SetReviewStatus(ctx, 9, repository.ReviewHidden); !errors.Is(err, exceptions.ErrReviewNotFound) {
+		t.Errorf("unknown review: %v", err)
+	}
+
+	reviews, err := s.GetHotelReviews(ctx, 1)
+	if err != nil || len(reviews) != 1 || reviews[0].Reply == nil || *reviews[0].Reply != "Спасибо!" {
+		t.Fatalf("reviews %+v, %v", reviews, err)
+	}
+	hotels, _ = s.GetAllHotels(ctx)
+	if hotels[0].Rating != 5 || hotels[0].ReviewsCount != 1 {
+		t.Errorf("hidden review still counted: %+v", hotels[0])
+	}
+}
@@ -1,7 +1,13 @@
 package kafka
 
 import (
+	"context"
+	"encoding/json"
 	"strings"
+	"sync/atomic"
+	"time"
+
+	"hotel-booking-system/package/events"
 
 	"github.com/sirupsen/logrus"
 
@@ -10,21 +16,37 @@ import (
 
 const (
 	sessionTimeout = 10000 // ms
-	noTimeout      = -1
+	// pollTimeout bounds each read, so that Start notices Stop.
+	pollTimeout = 100 * time.Millisecond
 )
 
+// Values of auto.offset.reset: where a consumer group without a committed
+// offset starts reading.
+const (
+	// OffsetEarliest replays the whole topic, for groups that must see every
+	// event.
+	OffsetEarliest = "earliest"
+	// OffsetLatest skips what was published before the group joined, for
+	// short-lived groups that only care about new events.
+	OffsetLatest = "latest"
+)
+
+// Handler processes one message. ctx carries the producer's trace context.
 type Handler interface {
-	HandleMessage(message []byte, topic kafka.TopicPartition, cn int) error
+	HandleMessage(ctx context.Context, message []byte, topic kafka.TopicPartition, cn int) error
 }
 
 type Consumer struct {
 	consumer       *kafka.Consumer
 	handler        Handler
-	stop           bool
 	consumerNumber int
+
+	started atomic.Bool
+	stop    chan struct{}
+	done    chan struct{}
 }
 
-func NewConsumer(handler Handler, address []string, topic, consumerGroup string, consumerNumber int) (*Consumer, error) {
+func NewConsumer(handler Handler, address []string, topics []string, consumerGroup, offsetReset string, consumerNumber int) (*Consumer, error) {
 	cfg := &kafka.ConfigMap{
 		"bootstrap.servers":        strings.Join(address, ","),
 		"group.id":                 consumerGroup,
@@ -32,7 +54,7 @@ func NewConsumer(handler Handler, address []string, topic, consumerGroup string,
 		"enable.auto.offset.store": false,
 		"enable.auto.commit":       true,
 		"auto.commit.interval.ms":  5000,
-		"auto.offset.reset":        "earliest",
+		"auto.offset.reset":        offsetReset,
 	}
 
 	c, err := kafka.NewConsumer(cfg)
@@ -40,44 +62,113 @@ func NewConsumer(handler Handler, address []string, topic, consumerGroup string,
 		return nil, err
 	}
 
-	if err = c.Subscribe(topic, nil); err != nil {
+	if err = c.SubscribeTopics(topics, nil); err != nil {
 		return nil, err
 	}
 	return &Consumer{
 		consumer:       c,
 		handler:        handler,
 		consumerNumber: consumerNumber,
+		stop:           make(chan struct{}),
+		done:           make(chan struct{}),
 	}, nil
 }
 
+// Start reads and handles messages until Stop is called. It must be called
+// at most once.
 func (c *Consumer) Start() {
+	c.started.Store(true)
+	defer close(c.done)
+
 	for {
-		if c.stop {
-			break
+		select {
+		case <-c.stop:
+			return
+		default:
 		}
-		kafkaMsg, err := c.consumer.ReadMessage(noTimeout)
+
+		kafkaMsg, err := c.consumer.ReadMessage(pollTimeout)
 		if err != nil {
-			logrus.Error(err)
+			if kafkaErr, ok := err.(kafka.Error); !ok || kafkaErr.Code() != kafka.ErrTimedOut {
+				logrus.Error(err)
+			}
 		}
 		if kafkaMsg == nil {
 			continue
 		}
-		if err = c.handler.HandleMessage(kafkaMsg.Value, kafkaMsg.TopicPartition, c.consumerNumber); err != nil {
-			logrus.Error(err)
-			continue
-		}
-		if _, err = c.consumer.StoreMessage(kafkaMsg); err != nil {
-			logrus.Error(err)
-			continue
-		}
+		c.recordLag(kafkaMsg)
+		c.handle(kafkaMsg)
+	}
+}
+
+// handle processes the message in a span that continues the producer's
+// trace, and stores its offset once the handler succeeds.
+func (c *Consumer) handle(kafkaMsg *kafka.Message) {
+	ctx, span := startProcess(kafkaMsg)
+	topic := topicName(kafkaMsg.TopicPartition)
+
+	value, err := decodeValue(kafkaMsg)
+	if err != nil {
+		endSpan(span, err)
+		messagesConsumed.WithLabelValues(topic, "undecodable").Inc()
+		logrus.Errorf("Failed to decode message at %v: %v", kafkaMsg.TopicPartition, err)
+		return
+	}
+	err = c.handler.HandleMessage(ctx, value, kafkaMsg.TopicPartition, c.consumerNumber)
+	endSpan(span, err)
+	if err != nil {
+		messagesConsumed.WithLabelValues(topic, "failed").Inc()
+		logrus.Error(err)
+		return
+	}
+	messagesConsumed.WithLabelValues(topic, "handled").Inc()
+	if _, err = c.consumer.StoreMessage(kafkaMsg); err != nil {
+		logrus.Error(err)
 	}
 }
 
+// Stop waits for Start to return, then commits the stored offsets and
+// closes the consumer. A consumer that handled nothing since the last commit
+// has no offsets to commit.
 func (c *Consumer) Stop() error {
-	c.stop = true
+	close(c.stop)
+	// A Start that has not set started yet sees stop closed before its
+	// first read, so it never touches the handle closed below.
+	if c.started.Load() {
+		<-c.done
+	}
 	if _, err := c.consumer.Commit(); err != nil {
-		return err
+		if kafkaErr, ok := err.(kafka.Error); !ok || kafkaErr.Code() != kafka.ErrNoOffset {
+			return err
+		}
+	} else {
+		logrus.Infof("Commited offset")
 	}
-	logrus.Infof("Commited offset")
 	return c.consumer.Close()
 }
+
+// decodeValue hands JSON messages to the handler as they are and converts
+// messages in any other encoding into a JSON envelope, so that handlers only
+// ever deal with one format.
+func decodeValue(msg *kafka.Message) ([]byte, error) {
+	var contentType string
+	for _, h := range msg.Headers {
+		if h.Key == events.ContentTypeHeader {
+			contentType = string(h.Value)
+		}
+	}
+
+	codec, err := events.CodecFor(contentType)
+	if err != nil {
+		return nil, err
+	}
+	if codec.ContentType() == events.ContentTypeJSON {
+		return msg.Value, nil
+	}
+
+	env, err := codec.Unmarshal(msg.Value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(env)
+}
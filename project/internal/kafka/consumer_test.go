@@ -0,0 +1,56 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+type nopHandler struct{}
+
+func (nopHandler) HandleMessage(context.Context, []byte, kafka.TopicPartition, int) error { return nil }
+
+func TestStopWaitsForStart(t *testing.T) {
+	// No broker listens here; the consumer only ever times out reading.
+	c, err := NewConsumer(nopHandler{}, []string{"127.0.0.1:1"}, []string{"test"}, "test", OffsetLatest, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	returned := make(chan struct{})
+	go func() {
+		c.Start()
+		close(returned)
+	}()
+	time.Sleep(2 * pollTimeout)
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- c.Stop() }()
+	select {
+	case err := <-stopped:
+		if err != nil {
+			t.Errorf("stop: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop did not return")
+	}
+	select {
+	case <-returned:
+	default:
+		t.Error("Stop returned before Start")
+	}
+}
+
+func TestStopBeforeStart(t *testing.T) {
+	c, err := NewConsumer(nopHandler{}, []string{"127.0.0.1:1"}, []string{"test"}, "test", OffsetLatest, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Stop(); err != nil {
+		t.Errorf("stop: %v", err)
+	}
+	// Start on a stopped consumer returns without reading.
+	c.Start()
+}
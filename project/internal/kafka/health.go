@@ -0,0 +1,49 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// metadataTimeout is used when the context has no deadline.
+const metadataTimeout = 5 * time.Second
+
+var errNoBrokers = errors.New("no brokers in cluster metadata")
+
+type metadataClient interface {
+	GetMetadata(topic *string, allTopics bool, timeoutMs int) (*kafka.Metadata, error)
+}
+
+// Ping asks the cluster for broker metadata.
+func (p *Producer) Ping(ctx context.Context) error {
+	return ping(ctx, p.producer)
+}
+
+// Ping asks the cluster for broker metadata.
+func (c *Consumer) Ping(ctx context.Context) error {
+	return ping(ctx, c.consumer)
+}
+
+// ping requests metadata without topics, which only lists the brokers.
+// librdkafka does not take a context, so its deadline becomes the timeout.
+func ping(ctx context.Context, client metadataClient) error {
+	timeout := metadataTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	if timeout <= 0 {
+		return context.DeadlineExceeded
+	}
+
+	md, err := client.GetMetadata(nil, false, int(timeout.Milliseconds()))
+	if err != nil {
+		return err
+	}
+	if len(md.Brokers) == 0 {
+		return errNoBrokers
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Package memory is an in-memory stand-in for Kafka, used by tests that
+// need to see the published events or hand them to the consumers' handlers.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"hotel-booking-system/internal/kafka"
+	"hotel-booking-system/package/events"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/sirupsen/logrus"
+)
+
+// Message is a published event. Value holds the JSON envelope, the form
+// consumers hand to their handlers.
+type Message struct {
+	Topic string
+	Key   string
+	Value []byte
+}
+
+// Event decodes the event carried by the message.
+func (m Message) Event() (events.Event, error) {
+	env, err := events.JSONCodec{}.Unmarshal(m.Value)
+	if err != nil {
+		return nil, err
+	}
+	return env.Decode()
+}
+
+// delivery is a message waiting for a subscriber. ctx is the producer's,
+// so the handler sees its trace and request ID.
+type delivery struct {
+	ctx     context.Context
+	message Message
+}
+
+type subscription struct {
+	handler kafka.Handler
+	topics  []string
+	queue   []delivery
+}
+
+// Bus records the envelopes published through ProduceEnvelope and delivers
+// them to the subscribed handlers. It is safe for concurrent use.
+type Bus struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	messages []Message
+	failure  error
+
+	subs    []*subscription
+	pending int
+	closed  bool
+}
+
+func NewBus() *Bus {
+	b := &Bus{}
+	b.cond = sync.NewCond(&b.mu)
+	return b
+}
+
+// Subscribe hands every message published to topics from now on to
+// handler, like a consumer group of its own. Messages are delivered in a
+// background goroutine one at a time in publish order; handler errors are
+// logged and the message is dropped, as the Kafka consumer does.
+func (b *Bus) Subscribe(handler kafka.Handler, topics ...string) {
+	sub := &subscription{handler: handler, topics: topics}
+
+	b.mu.Lock()
+	b.subs = append(b.subs, sub)
+	b.mu.Unlock()
+
+	go b.deliver(sub)
+}
+
+func (b *Bus) deliver(sub *subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for {
+		for len(sub.queue) == 0 && !b.closed {
+			b.cond.Wait()
+		}
+		if b.closed {
+			return
+		}
+		d := sub.queue[0]
+		sub.queue = sub.queue[1:]
+
+		b.mu.Unlock()
+		topic := d.message.Topic
+		err := sub.handler.HandleMessage(d.ctx, d.message.Value, ckafka.TopicPartition{Topic: &topic}, 1)
+		if err != nil {
+			logrus.Errorf("Failed to handle message on %s: %v", topic, err)
+		}
+		b.mu.Lock()
+
+		b.pending--
+		b.cond.Broadcast()
+	}
+}
+
+// Wait blocks until the subscribers have handled every message published
+// so far, or the bus is closed.
+func (b *Bus) Wait() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for b.pending > 0 && !b.closed {
+		b.cond.Wait()
+	}
+}
+
+// Close stops the deliveries. Messages still queued are dropped.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	b.cond.Broadcast()
+}
+
+// ProduceEnvelope encodes env as JSON, records it and queues it for the
+// subscribers, or returns the error set with Fail.
+func (b *Bus) ProduceEnvelope(ctx context.Context, env *events.Envelope, topic, key string) error {
+	value, err := events.JSONCodec{}.Marshal(env)
+	if err != nil {
+		return fmt.Errorf("failed to encode event %s: %w", env.EventID, err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failure != nil {
+		return b.failure
+	}
+	message := Message{Topic: topic, Key: key, Value: value}
+	b.messages = append(b.messages, message)
+
+	for _, sub := range b.subs {
+		if slices.Contains(sub.topics, topic) {
+			sub.queue = append(sub.queue, delivery{ctx: context.WithoutCancel(ctx), message: message})
+			b.pending++
+		}
+	}
+	b.cond.Broadcast()
+	return nil
+}
+
+// Fail makes ProduceEnvelope return err until Fail is called with nil.
+func (b *Bus) Fail(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failure = err
+}
+
+// Messages returns the messages published to topic in order, or all of
+// them when topic is empty.
+func (b *Bus) Messages(topic string) []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var result []Message
+	for _, m := range b.messages {
+		if topic == "" || m.Topic == topic {
+			result = append(result, m)
+		}
+	}
+	return result
+}
@@ -0,0 +1,53 @@
+package kafka
+
+import (
+	"strconv"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	messagesProduced = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "kafka_messages_produced_total",
+		Help: "Messages acknowledged by the brokers, by topic.",
+	}, []string{"topic"})
+
+	produceFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "kafka_produce_failures_total",
+		Help: "Messages that could not be produced, by topic.",
+	}, []string{"topic"})
+
+	messagesConsumed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "kafka_messages_consumed_total",
+		Help: "Messages read by consumers, by topic and result.",
+	}, []string{"topic", "result"})
+
+	consumerLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kafka_consumer_lag",
+		Help: "Messages behind the high watermark as of the last consumed message, by topic and partition.",
+	}, []string{"topic", "partition"})
+)
+
+func topicName(tp kafka.TopicPartition) string {
+	if tp.Topic == nil {
+		return ""
+	}
+	return *tp.Topic
+}
+
+// recordLag compares the consumed offset with the cached high watermark of
+// its partition, which librdkafka refreshes with every fetch.
+func (c *Consumer) recordLag(msg *kafka.Message) {
+	topic := topicName(msg.TopicPartition)
+	_, high, err := c.consumer.GetWatermarkOffsets(topic, msg.TopicPartition.Partition)
+	if err != nil || high < 0 {
+		return
+	}
+	lag := high - int64(msg.TopicPartition.Offset) - 1
+	if lag < 0 {
+		lag = 0
+	}
+	consumerLag.WithLabelValues(topic, strconv.Itoa(int(msg.TopicPartition.Partition))).Set(float64(lag))
+}
@@ -1,10 +1,13 @@
 package kafka
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
+	"hotel-booking-system/package/events"
+
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 )
 
@@ -16,9 +19,11 @@ var errUnknownType = errors.New("unknown event type")
 
 type Producer struct {
 	producer *kafka.Producer
+	codec    events.Codec
 }
 
-func NewProducer(address []string) (*Producer, error) {
+// NewProducer creates a producer that encodes envelopes with codec.
+func NewProducer(address []string, codec events.Codec) (*Producer, error) {
 	conf := &kafka.ConfigMap{
 		"bootstrap.servers": strings.Join(address, ","),
 	}
@@ -26,26 +31,84 @@ func NewProducer(address []string) (*Producer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error with new producer: %w", err)
 	}
-	return &Producer{producer: p}, nil
+	return &Producer{producer: p, codec: codec}, nil
 }
 
-func (p *Producer) Produce(message, topic string) error {
-	kafkaMsg := &kafka.Message{
+func (p *Producer) Produce(ctx context.Context, message, topic string) error {
+	return p.produce(ctx, &kafka.Message{
 		TopicPartition: kafka.TopicPartition{
 			Topic:     &topic,
 			Partition: kafka.PartitionAny,
 		},
 		Value: []byte(message),
 		Key:   nil,
+	})
+}
+
+// ProduceEnvelope encodes env with the producer's codec and names the codec
+// in the content-type header. Messages with the same key go to the same
+// partition; an empty key lets Kafka pick one.
+func (p *Producer) ProduceEnvelope(ctx context.Context, env *events.Envelope, topic, key string) error {
+	value, err := p.codec.Marshal(env)
+	if err != nil {
+		return fmt.Errorf("failed to encode event %s: %w", env.EventID, err)
 	}
-	kafkaChan := make(chan kafka.Event)
+
+	return p.produce(ctx, &kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic:     &topic,
+			Partition: kafka.PartitionAny,
+		},
+		Value: value,
+		Key:   messageKey(key),
+		Headers: []kafka.Header{
+			{Key: events.ContentTypeHeader, Value: []byte(p.codec.ContentType())},
+		},
+	})
+}
+
+func messageKey(key string) []byte {
+	if key == "" {
+		return nil
+	}
+	return []byte(key)
+}
+
+// produce sends the message with the trace context of ctx in its headers.
+func (p *Producer) produce(ctx context.Context, kafkaMsg *kafka.Message) error {
+	topic := topicName(kafkaMsg.TopicPartition)
+	_, span := startProduce(ctx, kafkaMsg)
+	err := p.deliver(ctx, kafkaMsg)
+	endSpan(span, err)
+	if err != nil {
+		produceFailures.WithLabelValues(topic).Inc()
+		return err
+	}
+	messagesProduced.WithLabelValues(topic).Inc()
+	return nil
+}
+
+// deliver waits for the delivery report until ctx is done. librdkafka keeps
+// retrying a message for message.timeout.ms, so without a deadline a broker
+// outage would block the caller for minutes. A message given up on may
+// still be delivered later, so a retry can duplicate it; the outbox
+// guarantees at-least-once delivery anyway.
+func (p *Producer) deliver(ctx context.Context, kafkaMsg *kafka.Message) error {
+	// Buffered, so that a report arriving after ctx is done does not block
+	// librdkafka.
+	kafkaChan := make(chan kafka.Event, 1)
 	if err := p.producer.Produce(kafkaMsg, kafkaChan); err != nil {
 		return err
 	}
-	e := <-kafkaChan
+	var e kafka.Event
+	select {
+	case e = <-kafkaChan:
+	case <-ctx.Done():
+		return fmt.Errorf("delivery to %s not confirmed: %w", topicName(kafkaMsg.TopicPartition), ctx.Err())
+	}
 	switch ev := e.(type) {
 	case *kafka.Message:
-		return nil
+		return ev.TopicPartition.Error
 	case kafka.Error:
 		return ev
 	default:
@@ -0,0 +1,90 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+
+	"hotel-booking-system/internal/package/tracing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// headerCarrier lets the propagator read and write trace context in message
+// headers.
+type headerCarrier struct {
+	msg *kafka.Message
+}
+
+func (c headerCarrier) Get(key string) string {
+	for _, h := range c.msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (c headerCarrier) Set(key, value string) {
+	for i, h := range c.msg.Headers {
+		if h.Key == key {
+			c.msg.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	c.msg.Headers = append(c.msg.Headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.msg.Headers))
+	for _, h := range c.msg.Headers {
+		keys = append(keys, h.Key)
+	}
+	return keys
+}
+
+// startProduce starts the producer span and writes its context into the
+// message headers.
+func startProduce(ctx context.Context, msg *kafka.Message) (context.Context, trace.Span) {
+	topic := topicName(msg.TopicPartition)
+	ctx, span := tracing.Tracer().Start(ctx, "send "+topic,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeSend,
+			semconv.MessagingDestinationName(topic),
+		),
+	)
+	if msg.Key != nil {
+		span.SetAttributes(semconv.MessagingKafkaMessageKey(string(msg.Key)))
+	}
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier{msg: msg})
+	return ctx, span
+}
+
+// startProcess continues the producer's trace from the message headers.
+func startProcess(msg *kafka.Message) (context.Context, trace.Span) {
+	topic := topicName(msg.TopicPartition)
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), headerCarrier{msg: msg})
+	return tracing.Tracer().Start(ctx, "process "+topic,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeProcess,
+			semconv.MessagingDestinationName(topic),
+			semconv.MessagingDestinationPartitionID(strconv.Itoa(int(msg.TopicPartition.Partition))),
+			semconv.MessagingKafkaOffset(int(msg.TopicPartition.Offset)),
+		),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,59 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"hotel-booking-system/package/events"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTraceContextTravelsInHeaders(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer provider.Shutdown(context.Background())
+
+	topic := events.TypeBookingCreated
+	msg := &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 2, Offset: 41},
+		Key:            []byte("7"),
+		Headers:        []kafka.Header{{Key: events.ContentTypeHeader, Value: []byte(events.ContentTypeJSON)}},
+	}
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "handler")
+	_, send := startProduce(ctx, msg)
+	endSpan(send, nil)
+	parent.End()
+
+	if got := (headerCarrier{msg: msg}).Get(events.ContentTypeHeader); got != events.ContentTypeJSON {
+		t.Errorf("content-type header %q was lost", got)
+	}
+	if (headerCarrier{msg: msg}).Get("traceparent") == "" {
+		t.Fatal("no traceparent header")
+	}
+
+	_, process := startProcess(msg)
+	endSpan(process, nil)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("got %d spans, want 3", len(spans))
+	}
+	sent, processed := spans[0], spans[2]
+	if sent.Name != "send booking-created" || processed.Name != "process booking-created" {
+		t.Errorf("names %q and %q", sent.Name, processed.Name)
+	}
+	if sent.Parent.SpanID() != parent.SpanContext().SpanID() {
+		t.Error("send span is not a child of the caller's span")
+	}
+	if processed.Parent.SpanID() != sent.SpanContext.SpanID() || !processed.Parent.IsRemote() {
+		t.Error("process span does not continue the send span")
+	}
+}
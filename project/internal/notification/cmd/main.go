@@ -1,15 +1,24 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"net/http"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
+	"time"
 
 	"hotel-booking-system/internal/handler"
 	"hotel-booking-system/internal/kafka"
 	"hotel-booking-system/internal/notification"
+	"hotel-booking-system/internal/notification/config"
+	"hotel-booking-system/internal/package/health"
+	"hotel-booking-system/internal/package/metrics"
+	"hotel-booking-system/internal/package/middleware"
+	"hotel-booking-system/internal/package/tracing"
+	"hotel-booking-system/package/events"
 
 	"github.com/joho/godotenv"
 	"github.com/sirupsen/logrus"
@@ -23,38 +32,68 @@ func main() {
 		logrus.Warn("No .env file found")
 	}
 
-	go func() {
-		addr := ":8090"
-		mux := http.NewServeMux()
-		mux.HandleFunc("/html_email", notification.HTMLTemplateEmailHandler)
-		mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusOK)
-		})
+	cfg, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		logrus.Fatalf("Invalid configuration: %v", err)
+	}
 
-		logrus.Infof("Starting notification HTTP server on %s", addr)
-		if err := http.ListenAndServe(addr, mux); err != nil {
-			logrus.Errorf("HTTP server failed: %v", err)
+	shutdownTracing, err := tracing.Setup(context.Background(), "notification-service")
+	if err != nil {
+		logrus.Fatalf("Failed to set up tracing: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logrus.Errorf("Failed to flush traces: %v", err)
 		}
 	}()
 
-	kafkaAddr := os.Getenv("KAFKA_BROKERS")
-	if kafkaAddr == "" {
-		kafkaAddr = "localhost:9091,localhost:9092,localhost:9093"
+	topics := []string{
+		events.TypeBookingCreated,
+		events.TypeBookingCancelled,
+		events.TypeBookingModified,
+		events.TypeBookingCheckedIn,
+		events.TypeBookingCheckedOut,
+		events.TypeBookingNoShow,
 	}
-	brokers := strings.Split(kafkaAddr, ",")
-	topic := "booking-created"
 	groupID := "notification-service-group"
 
 	notificationHandler := handler.NewHandler()
+	readiness := health.NewChecker()
 
-	for i := 1; i <= 3; i++ {
-		consumer, err := kafka.NewConsumer(notificationHandler, brokers, topic, groupID, i)
+	for i := 1; i <= cfg.Consumers; i++ {
+		consumer, err := kafka.NewConsumer(notificationHandler, cfg.Kafka.Brokers, topics, groupID, kafka.OffsetEarliest, i)
 		if err != nil {
 			logrus.Fatalf("Failed to create consumer %d: %v", i, err)
 		}
+		if i == 1 {
+			readiness.Add("kafka", consumer.Ping)
+		}
 		go consumer.Start()
 	}
 
+	go func() {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/html_email", notification.HTMLTemplateEmailHandler)
+		mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		mux.Handle("/ready", readiness)
+		mux.Handle("/metrics", metrics.Handler())
+
+		corsOrigins := middleware.Origins(cfg.CORSOrigins)
+		handler := middleware.Chain(tracing.HTTP(metrics.HTTP(mux)), append(middleware.Default(1<<20, corsOrigins), middleware.Timeout(30*time.Second))...)
+
+		logrus.Infof("Starting notification HTTP server on %s", cfg.HTTPAddr)
+		if err := http.ListenAndServe(cfg.HTTPAddr, handler); err != nil {
+			logrus.Errorf("HTTP server failed: %v", err)
+		}
+	}()
+
 	logrus.Info("Notification service started (Kafka consumers + HTTP)")
 
 	stop := make(chan os.Signal, 1)
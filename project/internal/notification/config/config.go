@@ -0,0 +1,52 @@
+// Package config holds the settings of the notification service.
+package config
+
+import (
+	"errors"
+
+	"hotel-booking-system/internal/package/config"
+)
+
+type Config struct {
+	Kafka config.Kafka
+
+	HTTPAddr    string
+	CORSOrigins string
+	// Consumers is the number of Kafka consumers in the service's group.
+	Consumers int
+}
+
+func Default() *Config {
+	return &Config{
+		Kafka:     config.DefaultKafka(),
+		HTTPAddr:  ":8090",
+		Consumers: 3,
+	}
+}
+
+// Load reads the config file, the environment and args and validates the
+// result.
+func Load(args []string) (*Config, error) {
+	cfg := Default()
+	l := config.New("notification")
+	l.Kafka(&cfg.Kafka)
+	l.String(&cfg.HTTPAddr, "http-addr", "HTTP_PORT", "HTTP listen address")
+	l.String(&cfg.CORSOrigins, "cors-origins", "CORS_ORIGINS", "comma-separated allowed CORS origins")
+	l.Int(&cfg.Consumers, "consumers", "KAFKA_CONSUMERS", "number of Kafka consumers")
+
+	if err := l.Load(args); err != nil {
+		return nil, err
+	}
+	return cfg, cfg.Validate()
+}
+
+func (c *Config) Validate() error {
+	errs := []error{c.Kafka.Validate()}
+	if c.HTTPAddr == "" {
+		errs = append(errs, errors.New("HTTP address is required"))
+	}
+	if c.Consumers < 1 {
+		errs = append(errs, errors.New("at least one Kafka consumer is required"))
+	}
+	return errors.Join(errs...)
+}
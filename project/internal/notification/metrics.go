@@ -0,0 +1,18 @@
+package notification
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	emailsSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "notification_emails_sent_total",
+		Help: "E-mails accepted by the SMTP server, by template.",
+	}, []string{"template"})
+
+	smtpErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "notification_smtp_errors_total",
+		Help: "E-mails the SMTP server did not accept, by template.",
+	}, []string{"template"})
+)
@@ -2,6 +2,7 @@ package notification
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -10,6 +11,12 @@ import (
 	"os"
 	"strings"
 	"text/template"
+
+	"hotel-booking-system/internal/package/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type EmailWithTemplateRequestBody struct {
@@ -44,7 +51,21 @@ func sendHtmlEmail(to []string, subject string, htmlBody string) error {
 	)
 }
 
-func SendEmailLogic(req EmailWithTemplateRequestBody) error {
+// SendEmailLogic renders the template and sends the e-mail in a span that is
+// a child of the one in ctx.
+func SendEmailLogic(ctx context.Context, req EmailWithTemplateRequestBody) (err error) {
+	_, span := tracing.Tracer().Start(ctx, "send email",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("email.template", req.Template)),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	to := strings.Split(req.ToAddr, ",")
 
 	tmplPath := "templates/" + req.Template + ".html"
@@ -59,8 +80,10 @@ func SendEmailLogic(req EmailWithTemplateRequestBody) error {
 	}
 
 	if err := sendHtmlEmail(to, req.Subject, rendered.String()); err != nil {
+		smtpErrors.WithLabelValues(req.Template).Inc()
 		return fmt.Errorf("failed to send email: %w", err)
 	}
+	emailsSent.WithLabelValues(req.Template).Inc()
 
 	return nil
 }
@@ -77,7 +100,7 @@ func HTMLTemplateEmailHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := SendEmailLogic(reqBody); err != nil {
+	if err := SendEmailLogic(r.Context(), reqBody); err != nil {
 		log.Printf("Error sending email: %v", err)
 		http.Error(w, "Failed to send email: "+err.Error(), http.StatusInternalServerError)
 		return
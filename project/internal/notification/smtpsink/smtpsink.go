@@ -0,0 +1,166 @@
+// Package smtpsink is a local SMTP server that keeps the e-mails it receives
+// instead of delivering them, so tests can check what notification sends.
+package smtpsink
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Message is a received e-mail.
+type Message struct {
+	From    string
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Sink accepts any sender, recipient and PLAIN credentials. It does not
+// offer STARTTLS; net/smtp sends PLAIN credentials over plain text only to
+// localhost, so FROM_EMAIL_SMTP has to be 127.0.0.1.
+type Sink struct {
+	listener net.Listener
+
+	mu       sync.Mutex
+	messages []Message
+	wg       sync.WaitGroup
+}
+
+// Start listens on a random local port.
+func Start() (*Sink, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+	s := &Sink{listener: listener}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Addr is the host:port to put into SMTP_ADDR.
+func (s *Sink) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Messages returns the received e-mails in order.
+func (s *Sink) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// Close stops accepting connections and waits for the open ones.
+func (s *Sink) Close() error {
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Sink) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			if err := s.session(textproto.NewConn(conn)); err != nil && err != io.EOF {
+				logrus.Errorf("SMTP sink session failed: %v", err)
+			}
+		}()
+	}
+}
+
+// session speaks just enough SMTP for net/smtp.SendMail.
+func (s *Sink) session(conn *textproto.Conn) error {
+	if err := conn.PrintfLine("220 localhost SMTP sink"); err != nil {
+		return err
+	}
+
+	var from string
+	var to []string
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return err
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			err = conn.PrintfLine("250-localhost\r\n250 AUTH PLAIN")
+		case "HELO", "NOOP":
+			err = conn.PrintfLine("250 OK")
+		case "AUTH":
+			err = conn.PrintfLine("235 Authentication succeeded")
+		case "MAIL":
+			from, to = address(arg), nil
+			err = conn.PrintfLine("250 OK")
+		case "RCPT":
+			to = append(to, address(arg))
+			err = conn.PrintfLine("250 OK")
+		case "DATA":
+			if err = conn.PrintfLine("354 End data with <CR><LF>.<CR><LF>"); err != nil {
+				return err
+			}
+			data, readErr := conn.ReadDotBytes()
+			if readErr != nil {
+				return readErr
+			}
+			if storeErr := s.store(from, to, data); storeErr != nil {
+				err = conn.PrintfLine("554 %v", storeErr)
+			} else {
+				err = conn.PrintfLine("250 OK")
+			}
+		case "RSET":
+			from, to = "", nil
+			err = conn.PrintfLine("250 OK")
+		case "QUIT":
+			return conn.PrintfLine("221 Bye")
+		default:
+			err = conn.PrintfLine("502 Command not implemented")
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Sink) store(from string, to []string, data []byte) error {
+	msg, err := mail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		return fmt.Errorf("failed to parse message: %w", err)
+	}
+	body, err := io.ReadAll(msg.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read message body: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, Message{
+		From:    from,
+		To:      to,
+		Subject: msg.Header.Get("Subject"),
+		Body:    string(body),
+	})
+	return nil
+}
+
+// address takes the mailbox out of "FROM:<a@b.c>" or "TO:<a@b.c>".
+func address(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr, _, _ = strings.Cut(addr, " ")
+	return strings.Trim(addr, "<>")
+}
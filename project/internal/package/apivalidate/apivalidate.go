@@ -0,0 +1,89 @@
+// Package apivalidate checks the request payloads of package/api/stable.
+// The payloads stay plain DTOs, so that other modules can import them.
+package apivalidate
+
+import (
+	"time"
+
+	"hotel-booking-system/internal/package/validate"
+	api "hotel-booking-system/package/api/stable"
+)
+
+const (
+	MaxGuests        = 10
+	MaxStayDays      = 365
+	MaxReviewLength  = 2000
+	MaxRoomNumberLen = 20
+)
+
+// CreateBookingRequest checks the request against now, so that check-in
+// dates before today are rejected.
+func CreateBookingRequest(r api.CreateBookingRequest, now time.Time) error {
+	return validate.Struct(
+		validate.Field("user_id", r.UserID, validate.Positive[int]()),
+		validate.Field("hotel_id", r.HotelID, validate.Positive[int]()),
+		validate.Field("room_type_id", r.RoomTypeID, validate.Positive[int]()),
+		checkInField(r.CheckInDate, now),
+		checkOutField(r.CheckInDate, r.CheckOutDate),
+		validate.Field("guests_count", r.GuestsCount, validate.Between(1, MaxGuests)),
+		validate.Field("loyalty_points", r.LoyaltyPoints, validate.Min(0)),
+	)
+}
+
+func ModifyBookingRequest(r api.ModifyBookingRequest, now time.Time) error {
+	return validate.Struct(
+		validate.Field("booking_id", r.BookingID, validate.Positive[int]()),
+		checkInField(r.CheckInDate, now),
+		checkOutField(r.CheckInDate, r.CheckOutDate),
+		validate.Field("guests_count", r.GuestsCount, validate.Between(1, MaxGuests)),
+	)
+}
+
+func BookingActionRequest(r api.BookingActionRequest) error {
+	return validate.Struct(
+		validate.Field("booking_id", r.BookingID, validate.Positive[int]()),
+	)
+}
+
+func CreateReviewRequest(r api.CreateReviewRequest) error {
+	return validate.Struct(
+		validate.Field("user_id", r.UserID, validate.Positive[int]()),
+		validate.Field("booking_id", r.BookingID, validate.Positive[int]()),
+		validate.Field("rating", r.Rating, validate.Between(1, 5)),
+		validate.Field("text", r.Text, validate.MaxLen(MaxReviewLength)),
+	)
+}
+
+func CalendarTokenRequest(r api.CalendarTokenRequest) error {
+	return validate.Struct(
+		validate.Field("user_id", r.UserID, validate.Positive[int]()),
+	)
+}
+
+func RoomPriceRequest(r api.RoomPriceRequest) error {
+	return validate.Struct(
+		validate.Field("price", r.Price, validate.Positive[float64]()),
+	)
+}
+
+func CreateRoomRequest(r api.CreateRoomRequest) error {
+	return validate.Struct(
+		validate.Field("room_number", r.RoomNumber, validate.Required[string](), validate.MaxLen(MaxRoomNumberLen)),
+	)
+}
+
+func checkInField(checkIn, now time.Time) validate.Check {
+	today := now.UTC().Truncate(24 * time.Hour)
+	return validate.Field("check_in_date", checkIn,
+		validate.Required[time.Time](),
+		validate.NotBefore(today, "today"),
+	)
+}
+
+func checkOutField(checkIn, checkOut time.Time) validate.Check {
+	return validate.Field("check_out_date", checkOut,
+		validate.Required[time.Time](),
+		validate.After(checkIn, "check_in_date"),
+		validate.NotAfter(checkIn.AddDate(0, 0, MaxStayDays), "365 days after check_in_date"),
+	)
+}
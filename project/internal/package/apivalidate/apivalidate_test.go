@@ -0,0 +1,102 @@
+package apivalidate
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"hotel-booking-system/internal/package/apperr"
+	api "hotel-booking-system/package/api/stable"
+)
+
+func TestCreateBookingRequest(t *testing.T) {
+	now := time.Date(2025, 6, 1, 15, 30, 0, 0, time.UTC)
+	today := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	valid := api.CreateBookingRequest{
+		UserID:       1,
+		HotelID:      2,
+		RoomTypeID:   3,
+		CheckInDate:  today,
+		CheckOutDate: today.AddDate(0, 0, 3),
+		GuestsCount:  2,
+	}
+
+	tests := []struct {
+		name   string
+		modify func(r *api.CreateBookingRequest)
+		fields []string
+	}{
+		{"valid", func(r *api.CreateBookingRequest) {}, nil},
+		{"negative guests", func(r *api.CreateBookingRequest) { r.GuestsCount = -1 }, []string{"guests_count"}},
+		{"too many guests", func(r *api.CreateBookingRequest) { r.GuestsCount = MaxGuests + 1 }, []string{"guests_count"}},
+		{"past check-in", func(r *api.CreateBookingRequest) { r.CheckInDate = today.AddDate(0, 0, -1) }, []string{"check_in_date"}},
+		{"check-out before check-in", func(r *api.CreateBookingRequest) { r.CheckOutDate = today }, []string{"check_out_date"}},
+		{"longer than a year", func(r *api.CreateBookingRequest) { r.CheckOutDate = today.AddDate(0, 0, MaxStayDays+1) }, []string{"check_out_date"}},
+		{"exactly a year", func(r *api.CreateBookingRequest) { r.CheckOutDate = today.AddDate(0, 0, MaxStayDays) }, nil},
+		{"missing dates", func(r *api.CreateBookingRequest) { r.CheckInDate, r.CheckOutDate = time.Time{}, time.Time{} }, []string{"check_in_date", "check_out_date"}},
+		{"negative points", func(r *api.CreateBookingRequest) { r.LoyaltyPoints = -5 }, []string{"loyalty_points"}},
+		{"missing ids", func(r *api.CreateBookingRequest) { r.UserID, r.HotelID, r.RoomTypeID = 0, -1, 0 }, []string{"user_id", "hotel_id", "room_type_id"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid
+			tt.modify(&req)
+			if got := invalidFields(CreateBookingRequest(req, now)); !slices.Equal(got, tt.fields) {
+				t.Errorf("invalid fields %v, want %v", got, tt.fields)
+			}
+		})
+	}
+}
+
+func TestCreateReviewRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    api.CreateReviewRequest
+		fields []string
+	}{
+		{"valid", api.CreateReviewRequest{UserID: 1, BookingID: 2, Rating: 5}, nil},
+		{"rating out of range", api.CreateReviewRequest{UserID: 1, BookingID: 2, Rating: 6}, []string{"rating"}},
+		{"missing booking", api.CreateReviewRequest{UserID: 1, Rating: 1}, []string{"booking_id"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := invalidFields(CreateReviewRequest(tt.req)); !slices.Equal(got, tt.fields) {
+				t.Errorf("invalid fields %v, want %v", got, tt.fields)
+			}
+		})
+	}
+}
+
+func TestRoomRequests(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		fields []string
+	}{
+		{"valid price", RoomPriceRequest(api.RoomPriceRequest{Price: 4500}), nil},
+		{"zero price", RoomPriceRequest(api.RoomPriceRequest{}), []string{"price"}},
+		{"valid room", CreateRoomRequest(api.CreateRoomRequest{RoomNumber: "101"}), nil},
+		{"missing number", CreateRoomRequest(api.CreateRoomRequest{}), []string{"room_number"}},
+		{"long number", CreateRoomRequest(api.CreateRoomRequest{RoomNumber: strings.Repeat("1", MaxRoomNumberLen+1)}), []string{"room_number"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := invalidFields(tt.err); !slices.Equal(got, tt.fields) {
+				t.Errorf("invalid fields %v, want %v", got, tt.fields)
+			}
+		})
+	}
+}
+
+func invalidFields(err error) []string {
+	var fields []string
+	for _, f := range apperr.FieldsOf(err) {
+		fields = append(fields, f.Field)
+	}
+	return fields
+}
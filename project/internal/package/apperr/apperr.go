@@ -0,0 +1,93 @@
+// Package apperr is the error model shared by the services. Errors carry a
+// machine-readable code, and the code alone decides the HTTP status and the
+// gRPC code the error is reported with.
+package apperr
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+type Code string
+
+const (
+	InvalidArgument    Code = "invalid_argument"
+	PermissionDenied   Code = "permission_denied"
+	NotFound           Code = "not_found"
+	AlreadyExists      Code = "already_exists"
+	Conflict           Code = "conflict"
+	FailedPrecondition Code = "failed_precondition"
+	TooLarge           Code = "too_large"
+	Unavailable        Code = "unavailable"
+	DeadlineExceeded   Code = "deadline_exceeded"
+	Internal           Code = "internal"
+)
+
+// Error is a domain error. Packages declare their sentinels with New and add
+// details by wrapping them: fmt.Errorf("%w: rating must be 1-5", ErrInvalid).
+// Fields lists the offending request fields of invalid_argument errors.
+type Error struct {
+	Code    Code
+	Message string
+	Fields  []FieldError
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func New(code Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// Invalid returns an invalid_argument error for the given fields.
+func Invalid(fields []FieldError) *Error {
+	return &Error{Code: InvalidArgument, Message: "validation failed", Fields: fields}
+}
+
+func (e *Error) Error() string {
+	if len(e.Fields) == 0 {
+		return e.Message
+	}
+	problems := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		problems[i] = f.Field + " " + f.Message
+	}
+	return e.Message + ": " + strings.Join(problems, "; ")
+}
+
+// CodeOf returns the code of the first Error in err's chain. Context errors
+// count as deadline_exceeded, everything else unclassified as internal.
+func CodeOf(err error) Code {
+	var e *Error
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &e):
+		return e.Code
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return DeadlineExceeded
+	default:
+		return Internal
+	}
+}
+
+// FieldsOf returns the field errors carried by err, if any.
+func FieldsOf(err error) []FieldError {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Fields
+	}
+	return nil
+}
+
+// Detail is the text of err that is safe to show to clients. Internal errors
+// may carry SQL or connection details, so they are reported generically.
+func Detail(err error) string {
+	if CodeOf(err) == Internal {
+		return "internal error"
+	}
+	return err.Error()
+}
@@ -0,0 +1,100 @@
+package apperr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errInvalid = New(InvalidArgument, "invalid request")
+
+func TestCodeOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Code
+	}{
+		{"sentinel", errInvalid, InvalidArgument},
+		{"wrapped", fmt.Errorf("failed to create: %w", fmt.Errorf("%w: bad dates", errInvalid)), InvalidArgument},
+		{"deadline", fmt.Errorf("query: %w", context.DeadlineExceeded), DeadlineExceeded},
+		{"unclassified", errors.New("connection refused"), Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CodeOf(tt.err); got != tt.want {
+				t.Errorf("CodeOf() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteHTTP(t *testing.T) {
+	tests := []struct {
+		err        error
+		wantStatus int
+		wantDetail string
+	}{
+		{fmt.Errorf("%w: rating must be between 1 and 5", errInvalid), http.StatusBadRequest, "invalid request: rating must be between 1 and 5"},
+		{New(NotFound, "record not found"), http.StatusNotFound, "record not found"},
+		{New(Unavailable, "hotel service is unavailable"), http.StatusServiceUnavailable, "hotel service is unavailable"},
+		{errors.New("pq: password authentication failed"), http.StatusInternalServerError, "internal error"},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		WriteHTTP(rec, tt.err)
+
+		if rec.Code != tt.wantStatus {
+			t.Errorf("%v: status %d, want %d", tt.err, rec.Code, tt.wantStatus)
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+			t.Errorf("%v: content type %q", tt.err, ct)
+		}
+		var problem Problem
+		if err := json.NewDecoder(rec.Body).Decode(&problem); err != nil {
+			t.Fatal(err)
+		}
+		if problem.Status != tt.wantStatus || problem.Detail != tt.wantDetail || problem.Code != CodeOf(tt.err) {
+			t.Errorf("%v: got %+v", tt.err, problem)
+		}
+	}
+}
+
+func TestGRPCRoundTrip(t *testing.T) {
+	for code := range grpcCodes {
+		err := GRPCStatus(fmt.Errorf("%w: details", New(code, string(code))))
+
+		if got := status.Code(err); got != GRPCCode(code) {
+			t.Errorf("%s: gRPC code %s, want %s", code, got, GRPCCode(code))
+		}
+		if got := FromGRPC(err); got != code {
+			t.Errorf("%s: read back as %s", code, got)
+		}
+	}
+}
+
+func TestFromGRPCWithoutDetails(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want Code
+	}{
+		{codes.NotFound, NotFound},
+		{codes.Unavailable, Unavailable},
+		{codes.Aborted, Conflict},
+		{codes.Unknown, Internal},
+	}
+
+	for _, tt := range tests {
+		if got := FromGRPC(status.Error(tt.code, "peer error")); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.code, got, tt.want)
+		}
+	}
+}
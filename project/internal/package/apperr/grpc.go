@@ -0,0 +1,122 @@
+package apperr
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Domain identifies our errors in the ErrorInfo detail of gRPC statuses.
+const Domain = "hotel-booking-system"
+
+var grpcCodes = map[Code]codes.Code{
+	InvalidArgument:    codes.InvalidArgument,
+	PermissionDenied:   codes.PermissionDenied,
+	NotFound:           codes.NotFound,
+	AlreadyExists:      codes.AlreadyExists,
+	Conflict:           codes.Aborted,
+	FailedPrecondition: codes.FailedPrecondition,
+	TooLarge:           codes.ResourceExhausted,
+	Unavailable:        codes.Unavailable,
+	DeadlineExceeded:   codes.DeadlineExceeded,
+	Internal:           codes.Internal,
+}
+
+func GRPCCode(code Code) codes.Code {
+	if c, ok := grpcCodes[code]; ok {
+		return c
+	}
+	return codes.Internal
+}
+
+// GRPCStatus converts err into a status with an ErrorInfo detail holding the
+// code, plus a BadRequest detail for field errors. Errors that already are
+// statuses are returned unchanged.
+func GRPCStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code := CodeOf(err)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason: string(code),
+		Domain: Domain,
+	}}
+	if fields := FieldsOf(err); len(fields) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, f := range fields {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       f.Field,
+				Description: f.Message,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	st := status.New(GRPCCode(code), Detail(err))
+	if withDetails, detailsErr := st.WithDetails(details...); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// UnaryServerInterceptor reports the errors returned by handlers through
+// GRPCStatus, so that handlers can return domain errors as they are.
+func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil && GRPCCode(CodeOf(err)) == codes.Internal {
+		logrus.WithField("method", info.FullMethod).Error(err)
+	}
+	return resp, GRPCStatus(err)
+}
+
+// FromGRPC returns the code a gRPC error was sent with: the ErrorInfo reason
+// when the peer is one of our services, otherwise the status code mapped back.
+func FromGRPC(err error) Code {
+	if err == nil {
+		return ""
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return DeadlineExceeded
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return CodeOf(err)
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == Domain {
+			return Code(info.Reason)
+		}
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return InvalidArgument
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return PermissionDenied
+	case codes.NotFound:
+		return NotFound
+	case codes.AlreadyExists:
+		return AlreadyExists
+	case codes.Aborted:
+		return Conflict
+	case codes.FailedPrecondition:
+		return FailedPrecondition
+	case codes.Unavailable, codes.ResourceExhausted:
+		return Unavailable
+	case codes.DeadlineExceeded, codes.Canceled:
+		return DeadlineExceeded
+	default:
+		return Internal
+	}
+}
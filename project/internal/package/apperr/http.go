@@ -0,0 +1,60 @@
+package apperr
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Problem is the RFC 7807 body of an error response, extended with the
+// machine-readable code and the list of invalid fields.
+type Problem struct {
+	Type   string       `json:"type"`
+	Title  string       `json:"title"`
+	Status int          `json:"status"`
+	Detail string       `json:"detail"`
+	Code   Code         `json:"code"`
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+var httpStatus = map[Code]int{
+	InvalidArgument:    http.StatusBadRequest,
+	PermissionDenied:   http.StatusForbidden,
+	NotFound:           http.StatusNotFound,
+	AlreadyExists:      http.StatusConflict,
+	Conflict:           http.StatusConflict,
+	FailedPrecondition: http.StatusConflict,
+	TooLarge:           http.StatusRequestEntityTooLarge,
+	Unavailable:        http.StatusServiceUnavailable,
+	DeadlineExceeded:   http.StatusGatewayTimeout,
+	Internal:           http.StatusInternalServerError,
+}
+
+func HTTPStatus(code Code) int {
+	if status, ok := httpStatus[code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// WriteHTTP writes err as application/problem+json. Server-side failures are
+// logged, since their details are not sent to the client.
+func WriteHTTP(w http.ResponseWriter, err error) {
+	code := CodeOf(err)
+	status := HTTPStatus(code)
+	if status >= http.StatusInternalServerError {
+		logrus.Error(err)
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: Detail(err),
+		Code:   code,
+		Errors: FieldsOf(err),
+	})
+}
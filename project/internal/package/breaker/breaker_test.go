@@ -0,0 +1,104 @@
+package breaker
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBreakerTransitions(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	var changes []string
+	b := New(2, time.Minute, func(from, to State) {
+		changes = append(changes, from.String()+"->"+to.String())
+	})
+	b.now = func() time.Time { return now }
+
+	call := func(failed bool) error {
+		if err := b.Allow(); err != nil {
+			return err
+		}
+		b.Record(failed)
+		return nil
+	}
+
+	// A success in between resets the count of consecutive failures.
+	for _, failed := range []bool{true, false, true} {
+		if err := call(failed); err != nil {
+			t.Fatalf("closed breaker rejected a call: %v", err)
+		}
+	}
+	if b.State() != Closed {
+		t.Fatalf("state %s, want closed", b.State())
+	}
+
+	_ = call(true)
+	if err := call(false); !errors.Is(err, ErrOpen) {
+		t.Fatalf("got %v, want ErrOpen", err)
+	}
+
+	// After the cooldown one probe goes through; a failed probe reopens.
+	now = now.Add(time.Minute)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("probe rejected: %v", err)
+	}
+	if err := b.Allow(); !errors.Is(err, ErrOpen) {
+		t.Fatalf("second call during the probe: %v, want ErrOpen", err)
+	}
+	b.Record(true)
+	if err := call(false); !errors.Is(err, ErrOpen) {
+		t.Fatalf("got %v after a failed probe, want ErrOpen", err)
+	}
+
+	now = now.Add(time.Minute)
+	if err := call(false); err != nil {
+		t.Fatalf("probe rejected: %v", err)
+	}
+	if b.State() != Closed {
+		t.Fatalf("state %s after a successful probe, want closed", b.State())
+	}
+
+	want := []string{
+		"closed->open",
+		"open->half-open", "half-open->open",
+		"open->half-open", "half-open->closed",
+	}
+	if !slices.Equal(changes, want) {
+		t.Errorf("changes %v, want %v", changes, want)
+	}
+}
+
+func TestBreakerRelease(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	b := New(2, time.Minute, nil)
+	b.now = func() time.Time { return now }
+
+	// A released call neither resets nor extends the failure streak.
+	for _, release := range []bool{false, true, false} {
+		if err := b.Allow(); err != nil {
+			t.Fatal(err)
+		}
+		if release {
+			b.Release()
+		} else {
+			b.Record(true)
+		}
+	}
+	if b.State() != Open {
+		t.Fatalf("state %s, want open", b.State())
+	}
+
+	// A released probe leaves the breaker half-open for the next one.
+	now = now.Add(time.Minute)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("probe rejected: %v", err)
+	}
+	b.Release()
+	if b.State() != HalfOpen {
+		t.Fatalf("state %s after a released probe, want half-open", b.State())
+	}
+	if err := b.Allow(); err != nil {
+		t.Fatalf("next probe rejected: %v", err)
+	}
+}
@@ -0,0 +1,208 @@
+// Package config loads service settings in layers: built-in defaults, the
+// YAML file, environment variables and command-line flags, each overriding
+// the values set by the ones before it.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFile is read when neither -config nor CONFIG_FILE is set. It is
+// optional, unlike a file named explicitly.
+const DefaultFile = "configs/database.yaml"
+
+// File is the layout of the YAML file.
+type File struct {
+	Databases map[string]Database `yaml:"databases"`
+	Kafka     Kafka               `yaml:"kafka"`
+}
+
+type field struct {
+	flag, env, usage string
+	set              func(string) error
+}
+
+// Loader binds config fields to a flag and an environment variable. Fields
+// are registered with the typed methods and filled by Load.
+type Loader struct {
+	name   string
+	fields []field
+	// fromFile copies the fields a service reads from the YAML file.
+	fromFile []func(File)
+	args     []string
+}
+
+// New returns a loader for the service name, used in flag usage messages.
+func New(name string) *Loader {
+	return &Loader{name: name}
+}
+
+func (l *Loader) add(name, env, usage string, set func(string) error) {
+	l.fields = append(l.fields, field{flag: name, env: env, usage: usage, set: set})
+}
+
+func (l *Loader) String(dst *string, name, env, usage string) {
+	l.add(name, env, usage, func(v string) error {
+		*dst = v
+		return nil
+	})
+}
+
+func (l *Loader) Int(dst *int, name, env, usage string) {
+	l.add(name, env, usage, func(v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return err
+		}
+		*dst = n
+		return nil
+	})
+}
+
+func (l *Loader) Bool(dst *bool, name, env, usage string) {
+	l.add(name, env, usage, func(v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+		*dst = b
+		return nil
+	})
+}
+
+func (l *Loader) Duration(dst *time.Duration, name, env, usage string) {
+	l.add(name, env, usage, func(v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*dst = d
+		return nil
+	})
+}
+
+// List reads a comma-separated list and drops empty items.
+func (l *Loader) List(dst *[]string, name, env, usage string) {
+	l.add(name, env, usage, func(v string) error {
+		*dst = splitList(v)
+		return nil
+	})
+}
+
+// Database binds dst to the section name of the file, to DB_HOST, DB_PORT,
+// DB_USER, DB_PASS, DB_NAME and DB_SSLMODE and to the -db-* flags.
+func (l *Loader) Database(dst *Database, name string) {
+	l.fromFile = append(l.fromFile, func(f File) {
+		if section, ok := f.Databases[name]; ok {
+			dst.merge(section)
+		}
+	})
+	l.String(&dst.Host, "db-host", "DB_HOST", "database host")
+	l.Int(&dst.Port, "db-port", "DB_PORT", "database port")
+	l.String(&dst.User, "db-user", "DB_USER", "database user")
+	l.String(&dst.Password, "db-password", "DB_PASS", "database password")
+	l.String(&dst.DBName, "db-name", "DB_NAME", "database name")
+	l.String(&dst.SSLMode, "db-sslmode", "DB_SSLMODE", "database sslmode")
+}
+
+// Kafka binds dst to the kafka section of the file, to KAFKA_BROKERS and
+// EVENT_CONTENT_TYPE and to -kafka-brokers and -event-content-type.
+func (l *Loader) Kafka(dst *Kafka) {
+	l.fromFile = append(l.fromFile, func(f File) {
+		dst.merge(f.Kafka)
+	})
+	l.List(&dst.Brokers, "kafka-brokers", "KAFKA_BROKERS", "comma-separated Kafka brokers")
+	l.String(&dst.ContentType, "event-content-type", "EVENT_CONTENT_TYPE", "content type of published events")
+}
+
+// Load parses args, which exclude the program name, and fills the
+// registered fields from the file, the environment and the flags.
+func (l *Loader) Load(args []string) error {
+	flags := flag.NewFlagSet(l.name, flag.ContinueOnError)
+	path := os.Getenv("CONFIG_FILE")
+	flags.StringVar(&path, "config", path, "YAML config file (default "+DefaultFile+")")
+
+	// Flags are only recorded here and applied last, after the file that
+	// -config names and the environment.
+	type setFlag struct {
+		field field
+		value string
+	}
+	var set []setFlag
+	for _, f := range l.fields {
+		flags.Func(f.flag, fmt.Sprintf("%s (env %s)", f.usage, f.env), func(v string) error {
+			set = append(set, setFlag{f, v})
+			return nil
+		})
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	l.args = flags.Args()
+
+	if err := l.loadFile(path); err != nil {
+		return err
+	}
+	for _, f := range l.fields {
+		v := os.Getenv(f.env)
+		if v == "" {
+			continue
+		}
+		if err := f.set(v); err != nil {
+			return fmt.Errorf("invalid %s: %w", f.env, err)
+		}
+	}
+	for _, s := range set {
+		if err := s.field.set(s.value); err != nil {
+			return fmt.Errorf("invalid -%s: %w", s.field.flag, err)
+		}
+	}
+	return nil
+}
+
+// Args returns the arguments left after the flags, e.g. a subcommand.
+func (l *Loader) Args() []string {
+	return l.args
+}
+
+func (l *Loader) loadFile(path string) error {
+	optional := path == ""
+	if optional {
+		path = DefaultFile
+	}
+	data, err := os.ReadFile(path)
+	if optional && errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var file File
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	for _, apply := range l.fromFile {
+		apply(file)
+	}
+	return nil
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
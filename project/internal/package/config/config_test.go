@@ -0,0 +1,110 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testFile = `
+databases:
+  booking:
+    host: "db.local"
+    port: 5436
+    user: "booking_user"
+    password: "booking_pass"
+    dbname: "booking_db"
+  hotel:
+    host: "other.local"
+kafka:
+  brokers: ["kafka1:9092", "kafka2:9092"]
+`
+
+type testConfig struct {
+	Database Database
+	Kafka    Kafka
+	Addr     string
+	Interval time.Duration
+}
+
+func load(t *testing.T, args ...string) (*testConfig, error) {
+	t.Helper()
+	cfg := &testConfig{Database: DefaultDatabase(), Kafka: DefaultKafka(), Addr: ":8080", Interval: time.Minute}
+	l := New("test")
+	l.Database(&cfg.Database, "booking")
+	l.Kafka(&cfg.Kafka)
+	l.String(&cfg.Addr, "addr", "TEST_ADDR", "listen address")
+	l.Duration(&cfg.Interval, "interval", "TEST_INTERVAL", "job interval")
+	return cfg, l.Load(args)
+}
+
+func TestLoadLayers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(testFile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CONFIG_FILE", path)
+	t.Setenv("DB_HOST", "env.local")
+	t.Setenv("DB_PORT", "5432")
+	t.Setenv("TEST_INTERVAL", "5s")
+
+	cfg, err := load(t, "-db-port", "6000", "-addr", ":9090")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Database{Host: "env.local", Port: 6000, User: "booking_user", Password: "booking_pass", DBName: "booking_db", SSLMode: "disable"}
+	if cfg.Database != want {
+		t.Errorf("database %+v, want %+v", cfg.Database, want)
+	}
+	if !slices.Equal(cfg.Kafka.Brokers, []string{"kafka1:9092", "kafka2:9092"}) {
+		t.Errorf("brokers %v", cfg.Kafka.Brokers)
+	}
+	if cfg.Addr != ":9090" || cfg.Interval != 5*time.Second {
+		t.Errorf("addr %q, interval %s", cfg.Addr, cfg.Interval)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	t.Run("default file is optional", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		cfg, err := load(t)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cfg.Database != DefaultDatabase() {
+			t.Errorf("database %+v, want defaults", cfg.Database)
+		}
+	})
+	t.Run("named file is required", func(t *testing.T) {
+		if _, err := load(t, "-config", filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+			t.Error("missing file accepted")
+		}
+	})
+}
+
+func TestLoadInvalid(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv("TEST_INTERVAL", "soon")
+	if _, err := load(t); err == nil || !strings.Contains(err.Error(), "TEST_INTERVAL") {
+		t.Errorf("got %v, want an error naming TEST_INTERVAL", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	db := DefaultDatabase()
+	db.SSLMode = "sometimes"
+	err := db.Validate()
+	for _, want := range []string{"user is required", "name is required", `sslmode "sometimes"`} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("got %v, want %q", err, want)
+		}
+	}
+
+	if err := (Kafka{ContentType: "text/plain"}).Validate(); err == nil {
+		t.Error("empty brokers and unknown content type accepted")
+	}
+}
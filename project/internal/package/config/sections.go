@@ -0,0 +1,105 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	db "hotel-booking-system/internal/package/database"
+	"hotel-booking-system/package/events"
+)
+
+var sslModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+type Database struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	DBName   string `yaml:"dbname"`
+	SSLMode  string `yaml:"sslmode"`
+}
+
+// DefaultDatabase is a local Postgres on the standard port.
+func DefaultDatabase() Database {
+	return Database{Host: "localhost", Port: 5432, SSLMode: "disable"}
+}
+
+// Connection returns the settings database.Connect takes.
+func (d Database) Connection() db.Config {
+	return db.Config{
+		Host:     d.Host,
+		Port:     d.Port,
+		User:     d.User,
+		Password: d.Password,
+		DBName:   d.DBName,
+		SSLMode:  d.SSLMode,
+	}
+}
+
+func (d Database) Validate() error {
+	var errs []error
+	if d.Host == "" {
+		errs = append(errs, errors.New("database host is required"))
+	}
+	if d.Port < 1 || d.Port > 65535 {
+		errs = append(errs, fmt.Errorf("database port %d is out of range", d.Port))
+	}
+	if d.User == "" {
+		errs = append(errs, errors.New("database user is required"))
+	}
+	if d.DBName == "" {
+		errs = append(errs, errors.New("database name is required"))
+	}
+	if !slices.Contains(sslModes, d.SSLMode) {
+		errs = append(errs, fmt.Errorf("unknown database sslmode %q", d.SSLMode))
+	}
+	return errors.Join(errs...)
+}
+
+// merge copies the fields set in other.
+func (d *Database) merge(other Database) {
+	setIfNotZero(&d.Host, other.Host)
+	setIfNotZero(&d.Port, other.Port)
+	setIfNotZero(&d.User, other.User)
+	setIfNotZero(&d.Password, other.Password)
+	setIfNotZero(&d.DBName, other.DBName)
+	setIfNotZero(&d.SSLMode, other.SSLMode)
+}
+
+type Kafka struct {
+	Brokers []string `yaml:"brokers"`
+	// ContentType of the events the service publishes, see events.CodecFor.
+	ContentType string `yaml:"content_type"`
+}
+
+// DefaultKafka is the three-broker cluster from docker-compose.yml as seen
+// from the host.
+func DefaultKafka() Kafka {
+	return Kafka{Brokers: []string{"localhost:9091", "localhost:9092", "localhost:9093"}}
+}
+
+func (k Kafka) Validate() error {
+	var errs []error
+	if len(k.Brokers) == 0 {
+		errs = append(errs, errors.New("kafka brokers are required"))
+	}
+	if _, err := events.CodecFor(k.ContentType); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (k *Kafka) merge(other Kafka) {
+	if len(other.Brokers) > 0 {
+		k.Brokers = other.Brokers
+	}
+	setIfNotZero(&k.ContentType, other.ContentType)
+}
+
+func setIfNotZero[T comparable](dst *T, v T) {
+	var zero T
+	if v != zero {
+		*dst = v
+	}
+}
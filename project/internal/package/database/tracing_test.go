@@ -0,0 +1,69 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var errQuery = errors.New("relation does not exist")
+
+type fakeConnector struct{}
+
+func (fakeConnector) Connect(context.Context) (driver.Conn, error) { return fakeConn{}, nil }
+func (fakeConnector) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (fakeConn) Close() error                        { return nil }
+func (fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (fakeConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+
+func (fakeConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return nil, errQuery
+}
+
+func TestTracedConnRecordsQueries(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	defer provider.Shutdown(context.Background())
+
+	db := sql.OpenDB(tracedConnector{fakeConnector{}})
+	defer db.Close()
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "handler")
+	if _, err := db.ExecContext(ctx, "\n\tupdate bookings SET status = $2 WHERE id = $1", 1, "cancelled"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.QueryContext(ctx, "SELECT id FROM missing"); !errors.Is(err, errQuery) {
+		t.Fatalf("got %v, want %v", err, errQuery)
+	}
+	parent.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("got %d spans, want 3", len(spans))
+	}
+	update, query := spans[0], spans[1]
+	if update.Name != "UPDATE" || query.Name != "SELECT" {
+		t.Errorf("names %q and %q", update.Name, query.Name)
+	}
+	if update.Parent.SpanID() != parent.SpanContext().SpanID() {
+		t.Error("query span is not a child of the caller's span")
+	}
+	if update.Status.Code == codes.Error || query.Status.Code != codes.Error {
+		t.Errorf("statuses %v and %v", update.Status.Code, query.Status.Code)
+	}
+}
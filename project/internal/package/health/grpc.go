@@ -0,0 +1,64 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// GRPC checks a service that implements grpc.health.v1. An empty service
+// asks for the server as a whole.
+func GRPC(conn grpc.ClientConnInterface, service string) Check {
+	client := healthpb.NewHealthClient(conn)
+	return func(ctx context.Context) error {
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("status %s", resp.GetStatus())
+		}
+		return nil
+	}
+}
+
+// GRPCServer is the grpc.health.v1 service backed by a Checker: the server
+// and each of services are SERVING while all dependencies are available.
+// Watch is not implemented.
+type GRPCServer struct {
+	healthpb.UnimplementedHealthServer
+	checker  *Checker
+	services []string
+}
+
+func NewGRPCServer(checker *Checker, services ...string) *GRPCServer {
+	return &GRPCServer{checker: checker, services: services}
+}
+
+func (s *GRPCServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if req.GetService() != "" && !slices.Contains(s.services, req.GetService()) {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+	return &healthpb.HealthCheckResponse{Status: s.servingStatus(ctx)}, nil
+}
+
+func (s *GRPCServer) List(ctx context.Context, _ *healthpb.HealthListRequest) (*healthpb.HealthListResponse, error) {
+	current := &healthpb.HealthCheckResponse{Status: s.servingStatus(ctx)}
+	statuses := map[string]*healthpb.HealthCheckResponse{"": current}
+	for _, service := range s.services {
+		statuses[service] = current
+	}
+	return &healthpb.HealthListResponse{Statuses: statuses}, nil
+}
+
+func (s *GRPCServer) servingStatus(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	if s.checker.Check(ctx).Status != StatusOK {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
@@ -0,0 +1,139 @@
+// Package health implements readiness probes: each dependency of a service
+// is checked with a timeout, the results are cached for a few seconds and
+// served as a JSON breakdown by /ready and the grpc.health.v1 service.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+)
+
+const (
+	// checkTimeout bounds every dependency check.
+	checkTimeout = 2 * time.Second
+	// cacheTTL is how long a report is reused, so that frequent probes do
+	// not add load on the dependencies.
+	cacheTTL = 5 * time.Second
+)
+
+// Check returns an error when the dependency cannot serve requests.
+type Check func(ctx context.Context) error
+
+// Report is the readiness of a service and each of its dependencies.
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]Result `json:"checks"`
+}
+
+type Result struct {
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+type dependency struct {
+	name  string
+	check Check
+}
+
+type Checker struct {
+	timeout      time.Duration
+	ttl          time.Duration
+	dependencies []dependency
+
+	mu        sync.Mutex
+	last      Report
+	checkedAt time.Time
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		timeout: checkTimeout,
+		ttl:     cacheTTL,
+	}
+}
+
+// Add registers a dependency. Dependencies have to be added before the
+// checker serves probes.
+func (c *Checker) Add(name string, check Check) {
+	c.dependencies = append(c.dependencies, dependency{name: name, check: check})
+}
+
+// Check runs all checks concurrently, or returns the cached report when it
+// is fresh. Concurrent callers wait for the same run.
+func (c *Checker) Check(ctx context.Context) Report {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.checkedAt.IsZero() && time.Since(c.checkedAt) < c.ttl {
+		return c.last
+	}
+
+	// A probe that gives up must not cache a failure for the others.
+	ctx = context.WithoutCancel(ctx)
+
+	results := make([]Result, len(c.dependencies))
+	var wg sync.WaitGroup
+	for i, dep := range c.dependencies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.run(ctx, dep)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]Result, len(results))}
+	for i, dep := range c.dependencies {
+		report.Checks[dep.name] = results[i]
+		if results[i].Status != StatusOK {
+			report.Status = StatusUnavailable
+		}
+	}
+
+	c.last = report
+	c.checkedAt = time.Now()
+	return report
+}
+
+func (c *Checker) run(ctx context.Context, dep dependency) Result {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := dep.check(ctx)
+	result := Result{Status: StatusOK, DurationMS: time.Since(start).Milliseconds()}
+	if err != nil {
+		logrus.Warnf("Readiness check %s failed: %v", dep.name, err)
+		result.Status = StatusUnavailable
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// ServeHTTP answers a readiness probe: 200 when every dependency is
+// available, 503 otherwise, with the report in both cases.
+func (c *Checker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	report := c.Check(r.Context())
+
+	status := http.StatusOK
+	if report.Status != StatusOK {
+		status = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		logrus.Errorf("Failed to encode readiness report: %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestReadyReportsEachDependency(t *testing.T) {
+	checker := NewChecker()
+	checker.timeout = 20 * time.Millisecond
+	checker.Add("database", func(context.Context) error { return nil })
+	checker.Add("kafka", func(context.Context) error { return errors.New("no brokers") })
+	checker.Add("hotel-srv", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	rec := httptest.NewRecorder()
+	checker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status %d, want 503", rec.Code)
+	}
+	var report Report
+	if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Status != StatusUnavailable {
+		t.Errorf("report status %q", report.Status)
+	}
+	want := map[string]string{
+		"database":  StatusOK,
+		"kafka":     StatusUnavailable,
+		"hotel-srv": StatusUnavailable,
+	}
+	for name, status := range want {
+		if got := report.Checks[name].Status; got != status {
+			t.Errorf("%s: %q, want %q", name, got, status)
+		}
+	}
+	if report.Checks["hotel-srv"].Error != context.DeadlineExceeded.Error() {
+		t.Errorf("hotel-srv error %q", report.Checks["hotel-srv"].Error)
+	}
+}
+
+func TestCheckCachesReport(t *testing.T) {
+	var calls atomic.Int32
+	checker := NewChecker()
+	checker.Add("database", func(context.Context) error {
+		calls.Add(1)
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for range 3 {
+		if report := checker.Check(ctx); report.Status != StatusOK {
+			t.Fatalf("status %q despite a cancelled probe", report.Status)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("checked %d times, want 1", calls.Load())
+	}
+
+	checker.checkedAt = time.Now().Add(-cacheTTL)
+	checker.Check(context.Background())
+	if calls.Load() != 2 {
+		t.Errorf("stale report was reused")
+	}
+}
+
+func TestGRPCHealth(t *testing.T) {
+	var dbErr error
+	checker := NewChecker()
+	checker.ttl = 0
+	checker.Add("database", func(context.Context) error { return dbErr })
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, NewGRPCServer(checker, "hotel.v1.HotelService"))
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+	if err := GRPC(conn, "")(ctx); err != nil {
+		t.Errorf("server check: %v", err)
+	}
+	if err := GRPC(conn, "hotel.v1.HotelService")(ctx); err != nil {
+		t.Errorf("service check: %v", err)
+	}
+	if err := GRPC(conn, "payments.v1.Payments")(ctx); status.Code(err) != codes.NotFound {
+		t.Errorf("unknown service: %v, want NotFound", err)
+	}
+
+	dbErr = errors.New("connection refused")
+	if err := GRPC(conn, "")(ctx); err == nil || err.Error() != "status NOT_SERVING" {
+		t.Errorf("got %v, want NOT_SERVING", err)
+	}
+}
@@ -0,0 +1,239 @@
+// Package ical renders and parses the subset of RFC 5545 used for booking
+// calendars: a VCALENDAR with all-day or timed VEVENTs.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405"
+	maxLineOctets  = 75
+)
+
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+	// AllDay renders Start and End as DATE values instead of DATE-TIME.
+	AllDay    bool
+	Cancelled bool
+}
+
+// Write renders events as a VCALENDAR. Lines are CRLF terminated and folded
+// at 75 octets as the RFC requires.
+func Write(w io.Writer, name string, events []Event, stamp time.Time) error {
+	bw := bufio.NewWriter(w)
+	line := func(s string) {
+		writeFolded(bw, s)
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//hotel-booking-system//booking-srv//EN")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:" + escapeText(name))
+
+	for _, e := range events {
+		line("BEGIN:VEVENT")
+		line("UID:" + e.UID)
+		line("DTSTAMP:" + stamp.UTC().Format(dateTimeFormat) + "Z")
+		if e.AllDay {
+			line("DTSTART;VALUE=DATE:" + e.Start.Format(dateFormat))
+			line("DTEND;VALUE=DATE:" + e.End.Format(dateFormat))
+		} else {
+			line("DTSTART:" + e.Start.UTC().Format(dateTimeFormat) + "Z")
+			line("DTEND:" + e.End.UTC().Format(dateTimeFormat) + "Z")
+		}
+		if e.Summary != "" {
+			line("SUMMARY:" + escapeText(e.Summary))
+		}
+		if e.Description != "" {
+			line("DESCRIPTION:" + escapeText(e.Description))
+		}
+		if e.Cancelled {
+			line("STATUS:CANCELLED")
+		} else {
+			line("STATUS:CONFIRMED")
+		}
+		line("TRANSP:OPAQUE")
+		line("END:VEVENT")
+	}
+
+	line("END:VCALENDAR")
+	return bw.Flush()
+}
+
+func writeFolded(w *bufio.Writer, s string) {
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		// Never split a multi-byte UTF-8 sequence.
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.WriteString(s[:cut])
+		w.WriteString("\r\n ")
+		s = s[cut:]
+		// Continuation lines start with a space that counts towards the limit.
+		limit = maxLineOctets - 1
+	}
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+var textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// Parse reads every VEVENT from an iCalendar stream. Events without DTEND
+// last one day for DATE values and zero time otherwise, as in RFC 5545.
+func Parse(r io.Reader) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events  []Event
+		current *Event
+		hasEnd  bool
+	)
+	for n, l := range lines {
+		name, params, value, ok := splitLine(l)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			current = &Event{}
+			hasEnd = false
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if current == nil {
+				return nil, fmt.Errorf("line %d: END:VEVENT without BEGIN", n+1)
+			}
+			if current.Start.IsZero() {
+				return nil, fmt.Errorf("line %d: event %q has no DTSTART", n+1, current.UID)
+			}
+			if !hasEnd {
+				current.End = current.Start
+				if current.AllDay {
+					current.End = current.Start.AddDate(0, 0, 1)
+				}
+			}
+			events = append(events, *current)
+			current = nil
+		case current == nil:
+			continue
+		case name == "UID":
+			current.UID = value
+		case name == "SUMMARY":
+			current.Summary = textUnescaper.Replace(value)
+		case name == "DESCRIPTION":
+			current.Description = textUnescaper.Replace(value)
+		case name == "STATUS":
+			current.Cancelled = strings.EqualFold(value, "CANCELLED")
+		case name == "DTSTART":
+			t, allDay, err := parseTime(params, value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n+1, err)
+			}
+			current.Start, current.AllDay = t, allDay
+		case name == "DTEND":
+			t, _, err := parseTime(params, value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n+1, err)
+			}
+			current.End, hasEnd = t, true
+		}
+	}
+
+	return events, nil
+}
+
+func unfold(r io.Reader) ([]string, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for sc.Scan() {
+		l := strings.TrimRight(sc.Text(), "\r")
+		if (strings.HasPrefix(l, " ") || strings.HasPrefix(l, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += l[1:]
+			continue
+		}
+		lines = append(lines, l)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+// splitLine splits a content line into its upper-cased name, parameters and
+// value. Parameter values may be quoted to contain ':', ';' and ','.
+func splitLine(l string) (string, map[string]string, string, bool) {
+	var parts []string
+	start, quoted := 0, false
+	for i := 0; i < len(l); i++ {
+		switch l[i] {
+		case '"':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				parts = append(parts, l[start:i])
+				start = i + 1
+			}
+		case ':':
+			if quoted {
+				continue
+			}
+			parts = append(parts, l[start:i])
+			params := make(map[string]string, len(parts)-1)
+			for _, p := range parts[1:] {
+				if k, v, ok := strings.Cut(p, "="); ok {
+					params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+				}
+			}
+			return strings.ToUpper(parts[0]), params, l[i+1:], true
+		}
+	}
+	return "", nil, "", false
+}
+
+func parseTime(params map[string]string, value string) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len(dateFormat) {
+		t, err := time.Parse(dateFormat, value)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(dateTimeFormat, strings.TrimSuffix(value, "Z"))
+		return t, false, err
+	}
+
+	// Without TZID the time is floating; it is read as UTC.
+	loc := time.UTC
+	if tzid := params["TZID"]; tzid != "" {
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("unknown TZID %q", tzid)
+		}
+		loc = l
+	}
+	t, err := time.ParseInLocation(dateTimeFormat, value, loc)
+	return t, false, err
+}
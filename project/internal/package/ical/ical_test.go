@@ -0,0 +1,266 @@
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestWriteFolded(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{"short", "SUMMARY:Room 101"},
+		{"exactly 75 octets", "SUMMARY:" + strings.Repeat("a", 67)},
+		{"ascii", "DESCRIPTION:" + strings.Repeat("abcdefghij", 20)},
+		{"two-byte runes", "SUMMARY:" + strings.Repeat("бронь ", 40)},
+		{"cut inside a rune", "SUMMARY:" + strings.Repeat("a", 66) + strings.Repeat("я", 10)},
+		{"four-byte runes", "SUMMARY:" + strings.Repeat("🏨", 40)},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		w := bufio.NewWriter(&buf)
+		writeFolded(w, tt.line)
+		w.Flush()
+
+		out := buf.String()
+		if !strings.HasSuffix(out, "\r\n") {
+			t.Errorf("%s: line not CRLF terminated", tt.name)
+		}
+		physical := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+		for i, p := range physical {
+			if len(p) > maxLineOctets {
+				t.Errorf("%s: line %d has %d octets", tt.name, i+1, len(p))
+			}
+			if i > 0 && !strings.HasPrefix(p, " ") {
+				t.Errorf("%s: continuation line %d does not start with a space", tt.name, i+1)
+			}
+			if !utf8.ValidString(p) {
+				t.Errorf("%s: line %d splits a multi-byte character", tt.name, i+1)
+			}
+		}
+
+		lines, err := unfold(strings.NewReader(out))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(lines) != 1 || lines[0] != tt.line {
+			t.Errorf("%s: unfolded to %q", tt.name, lines)
+		}
+	}
+}
+
+func TestEscapeRoundTrip(t *testing.T) {
+	tests := []struct {
+		text    string
+		escaped string
+	}{
+		{"plain", "plain"},
+		{"Double, non-smoking; sea view", `Double\, non-smoking\; sea view`},
+		{"line one\nline two", `line one\nline two`},
+		{`C:\new`, `C:\\new`},
+		{`trailing \`, `trailing \\`},
+	}
+
+	for _, tt := range tests {
+		if got := escapeText(tt.text); got != tt.escaped {
+			t.Errorf("escape %q: got %q, want %q", tt.text, got, tt.escaped)
+		}
+		if got := textUnescaper.Replace(tt.escaped); got != tt.text {
+			t.Errorf("unescape %q: got %q, want %q", tt.escaped, got, tt.text)
+		}
+	}
+	if got := textUnescaper.Replace(`a\Nb`); got != "a\nb" {
+		t.Errorf(`\N unescaped to %q`, got)
+	}
+}
+
+func TestWriteParseRoundTrip(t *testing.T) {
+	events := []Event{
+		{
+			UID:         "booking-1@hotel",
+			Summary:     "Гранд, Double; 2 guests",
+			Description: "Check-in after 14:00\nBring your passport",
+			Start:       time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+			End:         time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+			AllDay:      true,
+		},
+		{
+			UID:       "booking-2@hotel",
+			Summary:   "Cancelled stay",
+			Start:     time.Date(2026, 7, 5, 12, 0, 0, 0, time.UTC),
+			End:       time.Date(2026, 7, 6, 10, 0, 0, 0, time.UTC),
+			Cancelled: true,
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "Room 101", events, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Parse(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(events) {
+		t.Fatalf("parsed %d events, want %d", len(got), len(events))
+	}
+	for i := range events {
+		want, e := events[i], got[i]
+		if e.UID != want.UID || e.Summary != want.Summary || e.Description != want.Description ||
+			!e.Start.Equal(want.Start) || !e.End.Equal(want.End) ||
+			e.AllDay != want.AllDay || e.Cancelled != want.Cancelled {
+			t.Errorf("event %d: got %+v, want %+v", i, e, want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		props     string
+		start     time.Time
+		end       time.Time
+		allDay    bool
+		cancelled bool
+	}{
+		{
+			name:   "date",
+			props:  "DTSTART;VALUE=DATE:20260701\r\nDTEND;VALUE=DATE:20260703",
+			start:  time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+			end:    time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+			allDay: true,
+		},
+		{
+			name:   "date without VALUE",
+			props:  "DTSTART:20260701\r\nDTEND:20260702",
+			start:  time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+			end:    time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC),
+			allDay: true,
+		},
+		{
+			name:  "utc date-time",
+			props: "DTSTART:20260701T140000Z\r\nDTEND:20260702T100000Z",
+			start: time.Date(2026, 7, 1, 14, 0, 0, 0, time.UTC),
+			end:   time.Date(2026, 7, 2, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "floating date-time",
+			props: "DTSTART:20260701T140000\r\nDTEND:20260701T160000",
+			start: time.Date(2026, 7, 1, 14, 0, 0, 0, time.UTC),
+			end:   time.Date(2026, 7, 1, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "tzid",
+			props: "DTSTART;TZID=Europe/Moscow:20260701T140000\r\nDTEND;TZID=\"Europe/Moscow\":20260702T120000",
+			start: time.Date(2026, 7, 1, 14, 0, 0, 0, moscow),
+			end:   time.Date(2026, 7, 2, 12, 0, 0, 0, moscow),
+		},
+		{
+			name:   "missing dtend of a date",
+			props:  "DTSTART;VALUE=DATE:20260701",
+			start:  time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+			end:    time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC),
+			allDay: true,
+		},
+		{
+			name:  "missing dtend of a date-time",
+			props: "DTSTART:20260701T140000Z",
+			start: time.Date(2026, 7, 1, 14, 0, 0, 0, time.UTC),
+			end:   time.Date(2026, 7, 1, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "cancelled",
+			props:     "DTSTART:20260701\r\nSTATUS:CANCELLED",
+			start:     time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+			end:       time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC),
+			allDay:    true,
+			cancelled: true,
+		},
+		{
+			name:   "quoted parameter with a colon",
+			props:  "DTSTART;X-SOURCE=\"https://example.com:8443/a;b\";VALUE=DATE:20260701\r\nDTEND;VALUE=DATE:20260702",
+			start:  time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+			end:    time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC),
+			allDay: true,
+		},
+	}
+
+	for _, tt := range tests {
+		calendar := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1\r\n" + tt.props + "\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+		events, err := Parse(strings.NewReader(calendar))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if len(events) != 1 {
+			t.Errorf("%s: parsed %d events", tt.name, len(events))
+			continue
+		}
+		e := events[0]
+		if !e.Start.Equal(tt.start) || !e.End.Equal(tt.end) || e.AllDay != tt.allDay || e.Cancelled != tt.cancelled {
+			t.Errorf("%s: got %s – %s all day %v cancelled %v", tt.name, e.Start, e.End, e.AllDay, e.Cancelled)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		props string
+		want  string
+	}{
+		{"unknown tzid", "DTSTART;TZID=Mars/Olympus:20260701T140000", `unknown TZID "Mars/Olympus"`},
+		{"bad date", "DTSTART;VALUE=DATE:2026-07-01", "cannot parse"},
+		{"no dtstart", "SUMMARY:x", "has no DTSTART"},
+	}
+
+	for _, tt := range tests {
+		calendar := "BEGIN:VEVENT\r\nUID:1\r\n" + tt.props + "\r\nEND:VEVENT\r\n"
+		if _, err := Parse(strings.NewReader(calendar)); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: got %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestSplitLine(t *testing.T) {
+	tests := []struct {
+		line   string
+		name   string
+		params map[string]string
+		value  string
+	}{
+		{"uid:abc", "UID", map[string]string{}, "abc"},
+		{"DESCRIPTION:see https://example.com", "DESCRIPTION", map[string]string{}, "see https://example.com"},
+		{`ATTENDEE;CN="Doe; John";ROLE=CHAIR:mailto:j@example.com`, "ATTENDEE",
+			map[string]string{"CN": "Doe; John", "ROLE": "CHAIR"}, "mailto:j@example.com"},
+		{`X-LINK;altrep="http://example.com:80/x":text`, "X-LINK",
+			map[string]string{"ALTREP": "http://example.com:80/x"}, "text"},
+	}
+
+	for _, tt := range tests {
+		name, params, value, ok := splitLine(tt.line)
+		if !ok || name != tt.name || value != tt.value || len(params) != len(tt.params) {
+			t.Errorf("%q: got %q %v %q %v", tt.line, name, params, value, ok)
+			continue
+		}
+		for k, v := range tt.params {
+			if params[k] != v {
+				t.Errorf("%q: param %s = %q, want %q", tt.line, k, params[k], v)
+			}
+		}
+	}
+	if _, _, _, ok := splitLine(`X;P="unterminated:value`); ok {
+		t.Error("line without an unquoted colon accepted")
+	}
+}
@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestHTTPLabelsRequestsWithPattern(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/hotels/{hotel_id}/reviews", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	h := HTTP(mux)
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/hotels/7/reviews", nil))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/hotels/8/reviews", nil))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/nowhere", nil))
+
+	tests := []struct {
+		route, status string
+		want          float64
+	}{
+		{"GET /api/hotels/{hotel_id}/reviews", "418", 2},
+		{"unmatched", "404", 1},
+	}
+	for _, tt := range tests {
+		if got := testutil.ToFloat64(httpRequests.WithLabelValues(tt.route, http.MethodGet, tt.status)); got != tt.want {
+			t.Errorf("%s %s: %v requests, want %v", tt.route, tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestHandlerServesMetrics(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "go_goroutines") {
+		t.Errorf("status %d, body without go_goroutines", rec.Code)
+	}
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"hotel-booking-system/internal/package/requestctx"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	requestIDKey = "x-request-id"
+	actorKey     = "x-actor"
+)
+
+// UnaryClientRequestID forwards the request ID and actor from ctx to the
+// called service as x-request-id and x-actor metadata.
+func UnaryClientRequestID(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if requestID := requestctx.RequestID(ctx); requestID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, requestIDKey, requestID, actorKey, requestctx.Actor(ctx))
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// UnaryServerRequestID stores the caller's x-request-id and x-actor in the
+// context, generating a request ID when there is none, and echoes the
+// request ID in the response header.
+func UnaryServerRequestID(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := firstValue(md, requestIDKey)
+	if requestID == "" {
+		requestID = requestctx.NewRequestID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, requestID))
+
+	ctx = requestctx.WithRequestID(ctx, requestID)
+	ctx = requestctx.WithActor(ctx, firstValue(md, actorKey))
+	return handler(ctx, req)
+}
+
+// UnaryServerRecover turns a panic in a handler into a logged internal
+// error, the gRPC counterpart of Recover.
+func UnaryServerRecover(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			Logger(ctx).WithField("stack", string(debug.Stack())).Errorf("Panic in %s: %v", info.FullMethod, p)
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"hotel-booking-system/internal/package/apperr"
+	"hotel-booking-system/internal/package/requestctx"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testInfo = &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+func TestUnaryServerRequestID(t *testing.T) {
+	var requestID, actor string
+	handler := func(ctx context.Context, req any) (any, error) {
+		requestID, actor = requestctx.RequestID(ctx), requestctx.Actor(ctx)
+		return nil, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestIDKey, "abc", actorKey, "manager:1"))
+	if _, err := UnaryServerRequestID(ctx, nil, testInfo, handler); err != nil {
+		t.Fatal(err)
+	}
+	if requestID != "abc" || actor != "manager:1" {
+		t.Errorf("metadata not propagated: request ID %q, actor %q", requestID, actor)
+	}
+
+	if _, err := UnaryServerRequestID(context.Background(), nil, testInfo, handler); err != nil {
+		t.Fatal(err)
+	}
+	if requestID == "" || actor != requestctx.Actor(context.Background()) {
+		t.Errorf("without metadata: request ID %q, actor %q", requestID, actor)
+	}
+}
+
+func TestUnaryServerRecover(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		panic("boom")
+	}
+	recovering := func(ctx context.Context, req any) (any, error) {
+		return UnaryServerRecover(ctx, req, testInfo, handler)
+	}
+
+	_, err := apperr.UnaryServerInterceptor(context.Background(), nil, testInfo, recovering)
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("got %v, want an internal error", err)
+	}
+	if apperr.FromGRPC(err) != apperr.Internal || strings.Contains(status.Convert(err).Message(), "boom") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"hotel-booking-system/internal/package/apperr"
+	"hotel-booking-system/internal/package/requestctx"
+)
+
+func TestRequestID(t *testing.T) {
+	var seen string
+	h := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = requestctx.RequestID(r.Context())
+	}))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(RequestIDHeader, "abc")
+	h.ServeHTTP(rec, req)
+	if seen != "abc" || rec.Header().Get(RequestIDHeader) != "abc" {
+		t.Errorf("caller's ID not propagated: context %q, header %q", seen, rec.Header().Get(RequestIDHeader))
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if seen == "" || rec.Header().Get(RequestIDHeader) != seen {
+		t.Errorf("generated ID %q not echoed, header %q", seen, rec.Header().Get(RequestIDHeader))
+	}
+}
+
+func TestRecover(t *testing.T) {
+	h := Chain(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}), RequestID, AccessLog, Recover)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status %d, want 500", rec.Code)
+	}
+	var problem apperr.Problem
+	if err := json.NewDecoder(rec.Body).Decode(&problem); err != nil {
+		t.Fatal(err)
+	}
+	if problem.Code != apperr.Internal || strings.Contains(problem.Detail, "boom") {
+		t.Errorf("unexpected problem %+v", problem)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	var deadline time.Time
+	h := Timeout(time.Second)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deadline, _ = r.Context().Deadline()
+	}))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if left := time.Until(deadline); left <= 0 || left > time.Second {
+		t.Errorf("deadline %v is not within a second", deadline)
+	}
+}
+
+func TestMaxBytes(t *testing.T) {
+	h := MaxBytes(4)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); IsBodyTooLarge(err) {
+			apperr.WriteHTTP(w, ErrBodyTooLarge)
+		}
+	}))
+
+	tests := []struct {
+		name   string
+		req    *http.Request
+		status int
+	}{
+		{"small", httptest.NewRequest(http.MethodPost, "/", strings.NewReader("1234")), http.StatusOK},
+		{"content length", httptest.NewRequest(http.MethodPost, "/", strings.NewReader("12345")), http.StatusRequestEntityTooLarge},
+		{"unknown length", func() *http.Request {
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("12345"))
+			r.ContentLength = -1
+			return r
+		}(), http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, tt.req)
+		if rec.Code != tt.status {
+			t.Errorf("%s: status %d, want %d", tt.name, rec.Code, tt.status)
+		}
+	}
+}
+
+func TestCORS(t *testing.T) {
+	called := false
+	h := CORS([]string{"https://app.example"})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	tests := []struct {
+		name        string
+		origin      string
+		preflight   bool
+		allowOrigin string
+		status      int
+		called      bool
+	}{
+		{"allowed", "https://app.example", false, "https://app.example", http.StatusOK, true},
+		{"preflight", "https://app.example", true, "https://app.example", http.StatusNoContent, false},
+		{"other origin", "https://evil.example", false, "", http.StatusOK, true},
+	}
+
+	for _, tt := range tests {
+		called = false
+		method := http.MethodGet
+		if tt.preflight {
+			method = http.MethodOptions
+		}
+		req := httptest.NewRequest(method, "/", nil)
+		req.Header.Set("Origin", tt.origin)
+		if tt.preflight {
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		}
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tt.allowOrigin {
+			t.Errorf("%s: allow origin %q, want %q", tt.name, got, tt.allowOrigin)
+		}
+		if rec.Code != tt.status || called != tt.called {
+			t.Errorf("%s: status %d, handler called %v", tt.name, rec.Code, called)
+		}
+	}
+}
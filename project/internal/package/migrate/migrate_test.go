@@ -0,0 +1,73 @@
+package migrate
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"hotel-booking-system/migrations"
+)
+
+func versions(migrations []Migration) []int {
+	var result []int
+	for _, m := range migrations {
+		result = append(result, m.Version)
+	}
+	return result
+}
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"10_booking_outbox.sql":      {Data: []byte("CREATE TABLE outbox ();")},
+		"10_booking_outbox.down.sql": {Data: []byte("DROP TABLE outbox;")},
+		"2_booking_users.sql":        {Data: []byte("CREATE TABLE users ();")},
+		"2_booking_users.down.sql":   {Data: []byte("DROP TABLE users;")},
+		"3_hotel_rooms.sql":          {Data: []byte("CREATE TABLE rooms ();")},
+	}
+
+	got, err := Load(fsys, "booking")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(versions(got), []int{2, 10}) {
+		t.Fatalf("versions %v, want [2 10]", versions(got))
+	}
+	if got[1].Name != "outbox" || got[1].Down != "DROP TABLE outbox;" {
+		t.Errorf("got %+v", got[1])
+	}
+
+	if _, err := Load(fsys, "hotel"); err == nil {
+		t.Error("migration without a down file accepted")
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	for database, want := range map[string][]int{
+		"booking": {1, 3, 5, 6, 7, 8, 9, 10, 11, 12},
+		"hotel":   {2, 4},
+	} {
+		got, err := Load(migrations.FS, database)
+		if err != nil {
+			t.Fatalf("%s: %v", database, err)
+		}
+		if !slices.Equal(versions(got), want) {
+			t.Errorf("%s versions %v, want %v", database, versions(got), want)
+		}
+	}
+}
+
+func TestPlan(t *testing.T) {
+	all := []Migration{{Version: 1}, {Version: 2}, {Version: 3}, {Version: 4}}
+	applied := map[int]time.Time{1: time.Now(), 2: time.Now(), 3: time.Now()}
+
+	if got := versions(pending(all, applied)); !slices.Equal(got, []int{4}) {
+		t.Errorf("pending %v, want [4]", got)
+	}
+	if got := versions(rollback(all, applied, 2)); !slices.Equal(got, []int{3, 2}) {
+		t.Errorf("rollback %v, want [3 2]", got)
+	}
+	if got := versions(rollback(all, applied, 10)); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("rollback %v, want [3 2 1]", got)
+	}
+}
@@ -0,0 +1,136 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newRecorder(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+	return exporter
+}
+
+func attr(span tracetest.SpanStub, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestHTTPNamesSpanAfterRoute(t *testing.T) {
+	exporter := newRecorder(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/bookings/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !trace.SpanContextFromContext(r.Context()).IsValid() {
+			t.Error("handler context has no span")
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/bookings/7", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	HTTP(mux).ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name != "GET /api/bookings/{id}" {
+		t.Errorf("name %q", span.Name)
+	}
+	if got := attr(span, "http.route").AsString(); got != "/api/bookings/{id}" {
+		t.Errorf("http.route %q", got)
+	}
+	if got := attr(span, "http.response.status_code").AsInt64(); got != http.StatusServiceUnavailable {
+		t.Errorf("status code %d", got)
+	}
+	if span.Status.Code != codes.Error {
+		t.Errorf("span status %v, want error", span.Status.Code)
+	}
+	if got := span.SpanContext.TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("trace ID %s, want the caller's", got)
+	}
+	if got := span.Parent.SpanID().String(); got != "00f067aa0ba902b7" {
+		t.Errorf("parent span %s, want the caller's", got)
+	}
+}
+
+func TestGRPCOptionsPropagateTrace(t *testing.T) {
+	exporter := newRecorder(t)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(ServerOption())
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		DialOption(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx, parent := Tracer().Start(context.Background(), "parent")
+	if _, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	parent.End()
+	server.GracefulStop()
+
+	var client, served *tracetest.SpanStub
+	for _, span := range exporter.GetSpans() {
+		switch span.SpanKind {
+		case trace.SpanKindClient:
+			client = &span
+		case trace.SpanKindServer:
+			served = &span
+		}
+	}
+	if client == nil || served == nil {
+		t.Fatalf("missing client or server span in %v", exporter.GetSpans().Snapshots())
+	}
+	if client.SpanContext.TraceID() != parent.SpanContext().TraceID() {
+		t.Error("client span is not in the caller's trace")
+	}
+	if served.Parent.SpanID() != client.SpanContext.SpanID() {
+		t.Error("server span is not a child of the client span")
+	}
+}
+
+func TestSetupRejectsUnknownExporter(t *testing.T) {
+	t.Setenv("OTEL_TRACES_EXPORTER", "zipkin")
+	if _, err := Setup(context.Background(), "test"); err == nil {
+		t.Error("expected an error")
+	}
+}
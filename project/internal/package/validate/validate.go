@@ -0,0 +1,170 @@
+// Package validate checks request payloads declaratively: a payload lists its
+// fields with the rules they must satisfy, and every failed field is reported
+// at once as an invalid_argument error.
+//
+//	return validate.Struct(
+//		validate.Field("user_id", r.UserID, validate.Positive[int]()),
+//		validate.Field("name", r.Name, validate.Required[string](), validate.MaxLen(255)),
+//	)
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"hotel-booking-system/internal/package/apperr"
+)
+
+// Rule checks a value and returns what is wrong with it, or "" when it is
+// valid.
+type Rule[T any] func(T) string
+
+// Check validates one field.
+type Check func() *apperr.FieldError
+
+type number interface {
+	~int | ~int32 | ~int64 | ~float64
+}
+
+// Field applies rules to value in order and reports the first failure only,
+// so that a missing value is not also reported as out of range.
+func Field[T any](name string, value T, rules ...Rule[T]) Check {
+	return func() *apperr.FieldError {
+		for _, rule := range rules {
+			if msg := rule(value); msg != "" {
+				return &apperr.FieldError{Field: name, Message: msg}
+			}
+		}
+		return nil
+	}
+}
+
+// Struct runs the checks and returns nil or an apperr.Invalid error listing
+// every failed field.
+func Struct(checks ...Check) error {
+	var fields []apperr.FieldError
+	for _, check := range checks {
+		if f := check(); f != nil {
+			fields = append(fields, *f)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return apperr.Invalid(fields)
+}
+
+func Required[T comparable]() Rule[T] {
+	return func(v T) string {
+		var zero T
+		if v == zero {
+			return "is required"
+		}
+		return ""
+	}
+}
+
+func Positive[T number]() Rule[T] {
+	return func(v T) string {
+		if v <= 0 {
+			return "must be positive"
+		}
+		return ""
+	}
+}
+
+func Min[T number](min T) Rule[T] {
+	return func(v T) string {
+		if v < min {
+			return fmt.Sprintf("must be at least %v", min)
+		}
+		return ""
+	}
+}
+
+func Between[T number](min, max T) Rule[T] {
+	return func(v T) string {
+		if v < min || v > max {
+			return fmt.Sprintf("must be between %v and %v", min, max)
+		}
+		return ""
+	}
+}
+
+func OneOf[T comparable](values ...T) Rule[T] {
+	return func(v T) string {
+		if !slices.Contains(values, v) {
+			return fmt.Sprintf("must be one of %v", values)
+		}
+		return ""
+	}
+}
+
+// MaxLen limits the length of a string in characters.
+func MaxLen(max int) Rule[string] {
+	return func(v string) string {
+		if utf8.RuneCountInString(v) > max {
+			return fmt.Sprintf("must be at most %d characters", max)
+		}
+		return ""
+	}
+}
+
+func Matches(re *regexp.Regexp, msg string) Rule[string] {
+	return func(v string) string {
+		if !re.MatchString(v) {
+			return msg
+		}
+		return ""
+	}
+}
+
+var phonePattern = regexp.MustCompile(`^\+?[0-9]([0-9 ()-]*[0-9])?$`)
+
+// Phone accepts international and local numbers with 7 to 15 digits, written
+// with optional spaces, dashes and parentheses: "+7 (495) 123-45-67".
+func Phone() Rule[string] {
+	return func(v string) string {
+		digits := 0
+		for _, r := range v {
+			if r >= '0' && r <= '9' {
+				digits++
+			}
+		}
+		if !phonePattern.MatchString(v) || digits < 7 || digits > 15 {
+			return "must be a phone number"
+		}
+		return ""
+	}
+}
+
+// After requires a time strictly after t; what names t in the message.
+func After(t time.Time, what string) Rule[time.Time] {
+	return func(v time.Time) string {
+		if !v.After(t) {
+			return "must be after " + what
+		}
+		return ""
+	}
+}
+
+func NotBefore(t time.Time, what string) Rule[time.Time] {
+	return func(v time.Time) string {
+		if v.Before(t) {
+			return "must not be before " + what
+		}
+		return ""
+	}
+}
+
+func NotAfter(t time.Time, what string) Rule[time.Time] {
+	return func(v time.Time) string {
+		if v.After(t) {
+			return "must not be after " + what
+		}
+		return ""
+	}
+}
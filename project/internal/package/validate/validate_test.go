@@ -0,0 +1,90 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"hotel-booking-system/internal/package/apperr"
+)
+
+func TestRules(t *testing.T) {
+	day := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"required empty", Required[string]()(""), "is required"},
+		{"required set", Required[string]()("x"), ""},
+		{"positive zero", Positive[int]()(0), "must be positive"},
+		{"positive", Positive[int]()(3), ""},
+		{"min below", Min(0)(-1), "must be at least 0"},
+		{"between above", Between(1, 10)(11), "must be between 1 and 10"},
+		{"between upper bound", Between(1, 10)(10), ""},
+		{"one of unknown", OneOf("published", "hidden")("draft"), "must be one of [published hidden]"},
+		{"max len over", MaxLen(3)("abcd"), "must be at most 3 characters"},
+		{"max len counts runes", MaxLen(3)("абв"), ""},
+		{"after same time", After(day, "start")(day), "must be after start"},
+		{"not before earlier", NotBefore(day, "today")(day.AddDate(0, 0, -1)), "must not be before today"},
+		{"not after later", NotAfter(day, "the limit")(day.Add(time.Second)), "must not be after the limit"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestPhone(t *testing.T) {
+	tests := []struct {
+		phone string
+		valid bool
+	}{
+		{"+7 (495) 123-45-67", true},
+		{"84951234567", true},
+		{"123-45-67", true},
+		{"12345", false},
+		{"+7 495 abc 45 67", false},
+		{"+1234567890123456", false},
+		{"(495) 123-45-67", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := Phone()(tt.phone) == ""; got != tt.valid {
+			t.Errorf("Phone(%q) valid = %v, want %v", tt.phone, got, tt.valid)
+		}
+	}
+}
+
+func TestStruct(t *testing.T) {
+	err := Struct(
+		Field("id", 0, Required[int](), Positive[int]()),
+		Field("name", "ok", Required[string]()),
+		Field("count", -2, Min(0)),
+	)
+
+	var appErr *apperr.Error
+	if !errors.As(err, &appErr) || appErr.Code != apperr.InvalidArgument {
+		t.Fatalf("got %v, want an invalid_argument error", err)
+	}
+	want := []apperr.FieldError{
+		{Field: "id", Message: "is required"},
+		{Field: "count", Message: "must be at least 0"},
+	}
+	if len(appErr.Fields) != len(want) {
+		t.Fatalf("got fields %v, want %v", appErr.Fields, want)
+	}
+	for i := range want {
+		if appErr.Fields[i] != want[i] {
+			t.Errorf("field %d: got %v, want %v", i, appErr.Fields[i], want[i])
+		}
+	}
+
+	if err := Struct(Field("name", "ok", Required[string]())); err != nil {
+		t.Errorf("valid struct: %v", err)
+	}
+}
@@ -0,0 +1,3 @@
+DROP TABLE rooms;
+DROP TABLE room_types_in_hotels;
+DROP TABLE hotels;
@@ -0,0 +1,5 @@
+DROP INDEX idx_bookings_status_check_in;
+
+ALTER TABLE bookings DROP COLUMN penalty_amount;
+
+DROP TABLE cancellation_policies;
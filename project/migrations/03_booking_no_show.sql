@@ -0,0 +1,9 @@
+CREATE TABLE cancellation_policies (
+    hotel_id INTEGER PRIMARY KEY,
+    no_show_cutoff TIME NOT NULL DEFAULT '23:59',
+    no_show_penalty_nights INTEGER NOT NULL DEFAULT 1
+);
+
+ALTER TABLE bookings ADD COLUMN penalty_amount DECIMAL(10,2) NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_bookings_status_check_in ON bookings(status, check_in_date);
@@ -0,0 +1,4 @@
+DROP TABLE loyalty_ledger;
+DROP FUNCTION loyalty_ledger_append_only();
+
+ALTER TABLE bookings DROP COLUMN loyalty_discount;
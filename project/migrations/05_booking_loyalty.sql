@@ -0,0 +1,23 @@
+ALTER TABLE bookings ADD COLUMN loyalty_discount DECIMAL(10,2) NOT NULL DEFAULT 0;
+
+CREATE TABLE loyalty_ledger (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    booking_id INTEGER NOT NULL REFERENCES bookings(id),
+    entry_type TEXT NOT NULL,
+    points INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (booking_id, entry_type)
+);
+
+CREATE INDEX idx_loyalty_ledger_user ON loyalty_ledger(user_id);
+
+CREATE FUNCTION loyalty_ledger_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'loyalty_ledger is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_loyalty_ledger_append_only
+    BEFORE UPDATE OR DELETE ON loyalty_ledger
+    FOR EACH ROW EXECUTE FUNCTION loyalty_ledger_append_only();
@@ -0,0 +1,2 @@
+DROP TABLE room_blocks;
+DROP TABLE calendar_tokens;
@@ -0,0 +1,18 @@
+CREATE TABLE calendar_tokens (
+    user_id INTEGER PRIMARY KEY,
+    token TEXT UNIQUE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE room_blocks (
+    id SERIAL PRIMARY KEY,
+    room_id INTEGER NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    source TEXT NOT NULL,
+    external_uid TEXT NOT NULL,
+    summary TEXT NOT NULL DEFAULT '',
+    UNIQUE (room_id, source, external_uid)
+);
+
+CREATE INDEX idx_room_blocks_room_dates ON room_blocks(room_id, starts_at, ends_at);
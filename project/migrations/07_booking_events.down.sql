@@ -0,0 +1,2 @@
+DROP TABLE booking_events;
+DROP FUNCTION booking_events_append_only();
@@ -0,0 +1,22 @@
+CREATE TABLE booking_events (
+    id BIGSERIAL PRIMARY KEY,
+    booking_id INTEGER NOT NULL REFERENCES bookings(id),
+    action TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    request_id TEXT NOT NULL DEFAULT '',
+    before JSONB,
+    after JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_booking_events_booking ON booking_events(booking_id, id);
+
+CREATE FUNCTION booking_events_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'booking_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_booking_events_append_only
+    BEFORE UPDATE OR DELETE ON booking_events
+    FOR EACH ROW EXECUTE FUNCTION booking_events_append_only();
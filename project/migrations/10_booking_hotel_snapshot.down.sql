@@ -0,0 +1,5 @@
+ALTER TABLE bookings
+    DROP COLUMN hotel_name,
+    DROP COLUMN hotel_address,
+    DROP COLUMN hotel_phone,
+    DROP COLUMN room_type_name;
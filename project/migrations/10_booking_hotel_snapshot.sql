@@ -0,0 +1,5 @@
+ALTER TABLE bookings
+    ADD COLUMN hotel_name TEXT NOT NULL DEFAULT '',
+    ADD COLUMN hotel_address TEXT NOT NULL DEFAULT '',
+    ADD COLUMN hotel_phone TEXT NOT NULL DEFAULT '',
+    ADD COLUMN room_type_name TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+ALTER TABLE bookings DROP COLUMN saga_id;
+
+DROP TABLE sagas;
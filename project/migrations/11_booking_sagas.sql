@@ -0,0 +1,16 @@
+CREATE TABLE sagas (
+    id BIGSERIAL PRIMARY KEY,
+    saga_type TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'running',
+    step INTEGER NOT NULL DEFAULT 0,
+    data TEXT NOT NULL,
+    last_error TEXT,
+    actor TEXT NOT NULL,
+    request_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_sagas_in_flight ON sagas(updated_at) WHERE status IN ('running', 'compensating');
+
+ALTER TABLE bookings ADD COLUMN saga_id BIGINT UNIQUE REFERENCES sagas(id);
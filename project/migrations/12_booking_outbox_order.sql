@@ -0,0 +1 @@
+CREATE INDEX idx_outbox_pending_key ON outbox(message_key, id) WHERE sent_at IS NULL;
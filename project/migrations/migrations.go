@@ -0,0 +1,9 @@
+// Package migrations embeds the schema migrations of both databases.
+// NN_<database>_<name>.sql applies version NN, NN_<database>_<name>.down.sql
+// reverts it.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,160 @@
+// Package openapi holds the OpenAPI 3 documents of the HTTP APIs and the
+// clients generated from them.
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+//go:generate oapi-codegen -config bookingclient/config.yaml booking.json
+//go:generate oapi-codegen -config hotelclient/config.yaml hotel.json
+
+var (
+	//go:embed booking.json
+	BookingSpec []byte
+	//go:embed hotel.json
+	HotelSpec []byte
+)
+
+// Handler serves spec as the service's /openapi.json.
+func Handler(spec []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(spec)
+	}
+}
+
+type document struct {
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+		} `json:"schemas"`
+	} `json:"components"`
+}
+
+func parse(spec []byte) (*document, error) {
+	var doc document
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse openapi document: %w", err)
+	}
+	return &doc, nil
+}
+
+// Operations returns the operations of spec as sorted http.ServeMux patterns,
+// e.g. "GET /api/hotels/{hotel_id}/reviews".
+func Operations(spec []byte) ([]string, error) {
+	doc, err := parse(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var patterns []string
+	for path, item := range doc.Paths {
+		for method := range item {
+			if method == "parameters" {
+				continue
+			}
+			patterns = append(patterns, strings.ToUpper(method)+" "+path)
+		}
+	}
+	sort.Strings(patterns)
+	return patterns, nil
+}
+
+var pathParam = regexp.MustCompile(`\{[^}]+\}`)
+
+// CheckRoutes reports the operations of spec that routes does not serve,
+// the routes that spec does not document, and the documented paths that mux
+// sends to another pattern than their own. routes are http.ServeMux
+// patterns as registered on mux.
+func CheckRoutes(spec []byte, mux *http.ServeMux, routes []string) error {
+	documented, err := Operations(spec)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, op := range documented {
+		if !slices.Contains(routes, op) {
+			errs = append(errs, fmt.Errorf("%s is documented but not served", op))
+		}
+	}
+	for _, rt := range routes {
+		if !slices.Contains(documented, rt) {
+			errs = append(errs, fmt.Errorf("%s is served but not documented", rt))
+		}
+	}
+
+	// The documented paths must reach the handler registered for them.
+	for _, op := range documented {
+		method, path, _ := strings.Cut(op, " ")
+		req, err := http.NewRequest(method, "http://localhost"+pathParam.ReplaceAllString(path, "1"), nil)
+		if err != nil {
+			return err
+		}
+		if _, pattern := mux.Handler(req); pattern != op {
+			errs = append(errs, fmt.Errorf("%s is routed to %q", op, pattern))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CheckSchema reports an error when the properties of the named component
+// schema differ from the JSON fields of v, a struct or pointer to one.
+func CheckSchema(spec []byte, name string, v any) error {
+	doc, err := parse(spec)
+	if err != nil {
+		return err
+	}
+	schema, ok := doc.Components.Schemas[name]
+	if !ok {
+		return fmt.Errorf("schema %s is not defined", name)
+	}
+
+	var documented []string
+	for property := range schema.Properties {
+		documented = append(documented, property)
+	}
+	sort.Strings(documented)
+
+	actual := jsonFields(reflect.TypeOf(v))
+	if !slices.Equal(documented, actual) {
+		return fmt.Errorf("schema %s has properties %v, but %T encodes %v", name, documented, v, actual)
+	}
+	return nil
+}
+
+func jsonFields(t reflect.Type) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var fields []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -3,12 +3,13 @@ package stable
 import "time"
 
 type CreateBookingRequest struct {
-	UserID       int       `json:"user_id"`
-	HotelID      int       `json:"hotel_id"`
-	RoomTypeID   int       `json:"room_type_id"`
-	CheckInDate  time.Time `json:"check_in_date"`
-	CheckOutDate time.Time `json:"check_out_date"`
-	GuestsCount  int       `json:"guests_count"`
+	UserID        int       `json:"user_id"`
+	HotelID       int       `json:"hotel_id"`
+	RoomTypeID    int       `json:"room_type_id"`
+	CheckInDate   time.Time `json:"check_in_date"`
+	CheckOutDate  time.Time `json:"check_out_date"`
+	GuestsCount   int       `json:"guests_count"`
+	LoyaltyPoints int       `json:"loyalty_points"`
 }
 
 type CreateBookingResponse struct {
@@ -26,3 +27,59 @@ type BookingDTO struct {
 	CheckInDate time.Time `json:"check_in_date"`
 	TotalPrice  float64   `json:"total_price"`
 }
+
+type BookingActionRequest struct {
+	BookingID int `json:"booking_id"`
+}
+
+type ModifyBookingRequest struct {
+	BookingID    int       `json:"booking_id"`
+	CheckInDate  time.Time `json:"check_in_date"`
+	CheckOutDate time.Time `json:"check_out_date"`
+	GuestsCount  int       `json:"guests_count"`
+}
+
+type CreateReviewRequest struct {
+	UserID    int    `json:"user_id"`
+	BookingID int    `json:"booking_id"`
+	Rating    int    `json:"rating"`
+	Text      string `json:"text"`
+}
+
+type CreateReviewResponse struct {
+	ReviewID int `json:"review_id"`
+}
+
+type CalendarTokenRequest struct {
+	UserID int `json:"user_id"`
+}
+
+type CalendarTokenResponse struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+type ImportCalendarResponse struct {
+	RoomID   int `json:"room_id"`
+	Imported int `json:"imported"`
+}
+
+type ReviewReplyRequest struct {
+	Reply string `json:"reply"`
+}
+
+type ReviewStatusRequest struct {
+	Status string `json:"status"`
+}
+
+type RoomPriceRequest struct {
+	Price float64 `json:"price"`
+}
+
+type CreateRoomRequest struct {
+	RoomNumber string `json:"room_number"`
+}
+
+type CreateRoomResponse struct {
+	RoomID int `json:"room_id"`
+}
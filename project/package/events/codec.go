@@ -0,0 +1,137 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+
+	eventsv1 "hotel-booking-system/package/proto/events/stable"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// ContentTypeHeader is the Kafka message header that names the codec.
+	// Messages without it are JSON.
+	ContentTypeHeader = "content-type"
+
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+// Codec serializes envelopes for the wire.
+type Codec interface {
+	ContentType() string
+	Marshal(env *Envelope) ([]byte, error)
+	Unmarshal(data []byte) (*Envelope, error)
+}
+
+// CodecFor returns the codec for a content-type header value. An empty value
+// selects JSON so that messages from producers that predate the header keep
+// working.
+func CodecFor(contentType string) (Codec, error) {
+	switch contentType {
+	case "", ContentTypeJSON:
+		return JSONCodec{}, nil
+	case ContentTypeProtobuf:
+		return ProtobufCodec{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported content type %q", contentType)
+	}
+}
+
+type JSONCodec struct{}
+
+func (JSONCodec) ContentType() string { return ContentTypeJSON }
+
+func (JSONCodec) Marshal(env *Envelope) ([]byte, error) {
+	return json.Marshal(env)
+}
+
+func (JSONCodec) Unmarshal(data []byte) (*Envelope, error) {
+	var env Envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, err
+	}
+	return &env, nil
+}
+
+// protoRegistry maps every registered event schema to its protobuf message.
+// Proto field names match the JSON tags of the Go payloads, which lets the
+// codec convert between them through protojson.
+var protoRegistry = map[Key]func() proto.Message{
+	{TypeBookingCreated, 1}:    func() proto.Message { return &eventsv1.BookingCreated{} },
+	{TypeBookingCancelled, 1}:  func() proto.Message { return &eventsv1.BookingCancelled{} },
+	{TypeBookingModified, 1}:   func() proto.Message { return &eventsv1.BookingModified{} },
+	{TypeBookingCheckedIn, 1}:  func() proto.Message { return &eventsv1.BookingCheckedIn{} },
+	{TypeBookingCheckedOut, 1}: func() proto.Message { return &eventsv1.BookingCheckedOut{} },
+	{TypeBookingNoShow, 1}:     func() proto.Message { return &eventsv1.BookingNoShow{} },
+	{TypeRoomPriceChanged, 1}:  func() proto.Message { return &eventsv1.RoomPriceChanged{} },
+	{TypeRoomsChanged, 1}:      func() proto.Message { return &eventsv1.RoomsChanged{} },
+}
+
+type ProtobufCodec struct{}
+
+func (ProtobufCodec) ContentType() string { return ContentTypeProtobuf }
+
+func (ProtobufCodec) Marshal(env *Envelope) ([]byte, error) {
+	payload, err := newProtoPayload(env.Type, env.SchemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	if err := protojson.Unmarshal(env.Data, payload); err != nil {
+		return nil, fmt.Errorf("failed to convert %s v%d to protobuf: %w", env.Type, env.SchemaVersion, err)
+	}
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&eventsv1.EventEnvelope{
+		EventId:       env.EventID,
+		Type:          env.Type,
+		SchemaVersion: int32(env.SchemaVersion),
+		OccurredAt:    timestamppb.New(env.OccurredAt),
+		Producer:      env.Producer,
+		TraceContext:  env.TraceContext,
+		Data:          data,
+	})
+}
+
+func (ProtobufCodec) Unmarshal(data []byte) (*Envelope, error) {
+	var pb eventsv1.EventEnvelope
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, err
+	}
+
+	payload, err := newProtoPayload(pb.Type, int(pb.SchemaVersion))
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(pb.Data, payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s v%d: %w", pb.Type, pb.SchemaVersion, err)
+	}
+	jsonData, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Envelope{
+		EventID:       pb.EventId,
+		Type:          pb.Type,
+		SchemaVersion: int(pb.SchemaVersion),
+		OccurredAt:    pb.OccurredAt.AsTime(),
+		Producer:      pb.Producer,
+		TraceContext:  pb.TraceContext,
+		Data:          jsonData,
+	}, nil
+}
+
+func newProtoPayload(eventType string, version int) (proto.Message, error) {
+	factory, ok := protoRegistry[Key{eventType, version}]
+	if !ok {
+		return nil, fmt.Errorf("no protobuf schema for %s v%d", eventType, version)
+	}
+	return factory(), nil
+}
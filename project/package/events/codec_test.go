@@ -0,0 +1,257 @@
+package events
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// samples holds one payload per registered schema with every field set, so
+// that a field the protobuf codec drops shows up as a changed payload.
+var samples = map[Key]Event{
+	{TypeBookingCreated, 1}: BookingCreatedEvent{
+		BookingID:    7,
+		UserEmail:    "maria.petrova@mail.ru",
+		UserName:     "Мария Петрова",
+		HotelName:    "Сочи Марин",
+		CheckInDate:  "2024-02-01",
+		CheckOutDate: "2024-02-05",
+		Amount:       30000.5,
+		HotelAddress: "Сочи, ул. Приморская, 12",
+		HotelPhone:   "+7 (862) 555-01-02",
+		RoomTypeName: "Стандарт",
+	},
+	{TypeBookingCancelled, 1}: BookingCancelledEvent{
+		BookingID:      11,
+		UserID:         5,
+		UserEmail:      "ivan.sidorov@yandex.ru",
+		UserName:       "Иван Сидоров",
+		HotelID:        2,
+		RoomID:         8,
+		CheckInDate:    "2024-05-10",
+		CheckOutDate:   "2024-05-12",
+		TotalPrice:     9000.5,
+		PreviousStatus: "confirmed",
+	},
+	{TypeBookingModified, 1}: BookingModifiedEvent{
+		BookingID: 9,
+		UserID:    3,
+		UserEmail: "maria.petrova@mail.ru",
+		UserName:  "Мария Петрова",
+		HotelID:   2,
+		RoomID:    9,
+		Before:    BookingTerms{CheckInDate: "2024-04-01", CheckOutDate: "2024-04-03", GuestsCount: 2, TotalPrice: 14000},
+		After:     BookingTerms{CheckInDate: "2024-04-02", CheckOutDate: "2024-04-05", GuestsCount: 1, TotalPrice: 21000},
+	},
+	{TypeBookingCheckedIn, 1}: BookingCheckedInEvent{
+		BookingID:    12,
+		UserID:       6,
+		UserEmail:    "olga.smirnova@gmail.com",
+		UserName:     "Ольга Смирнова",
+		HotelID:      1,
+		RoomID:       3,
+		CheckInDate:  "2024-06-01",
+		CheckOutDate: "2024-06-04",
+	},
+	{TypeBookingCheckedOut, 1}: BookingCheckedOutEvent{
+		BookingID:    10,
+		UserID:       4,
+		UserEmail:    "olga.smirnova@gmail.com",
+		UserName:     "Ольга Смирнова",
+		HotelID:      1,
+		RoomID:       3,
+		CheckInDate:  "2024-06-01",
+		CheckOutDate: "2024-06-04",
+		TotalPrice:   6000,
+		PointsEarned: 60,
+	},
+	{TypeBookingNoShow, 1}: BookingNoShowEvent{
+		BookingID:    8,
+		UserID:       2,
+		HotelID:      3,
+		RoomID:       14,
+		CheckInDate:  "2024-03-01",
+		CheckOutDate: "2024-03-04",
+		Penalty:      12000,
+		UserEmail:    "maria.petrova@mail.ru",
+		UserName:     "Мария Петрова",
+	},
+	{TypeRoomPriceChanged, 1}: RoomPriceChangedEvent{HotelID: 1, RoomTypeID: 2, Price: 4500.5},
+	{TypeRoomsChanged, 1}:     RoomsChangedEvent{HotelID: 1, RoomTypeID: 2, RoomIDs: []int{3, 4, 9}},
+}
+
+// zeroFields lists the fields of v that hold their zero value.
+func zeroFields(prefix string, v reflect.Value) []string {
+	var zero []string
+	for i := 0; i < v.NumField(); i++ {
+		name := prefix + v.Type().Field(i).Name
+		f := v.Field(i)
+		if f.Kind() == reflect.Struct {
+			zero = append(zero, zeroFields(name+".", f)...)
+		} else if f.IsZero() {
+			zero = append(zero, name)
+		}
+	}
+	return zero
+}
+
+func TestCodecsRoundTrip(t *testing.T) {
+	for _, k := range Registered() {
+		sample, ok := samples[k]
+		if !ok {
+			t.Errorf("no sample for %s v%d", k.Type, k.Version)
+			continue
+		}
+		if sample.EventType() != k.Type || sample.SchemaVersion() != k.Version {
+			t.Errorf("sample for %s v%d is %s v%d", k.Type, k.Version, sample.EventType(), sample.SchemaVersion())
+		}
+		if zero := zeroFields("", reflect.ValueOf(sample)); len(zero) > 0 {
+			t.Errorf("sample for %s v%d leaves %v unset", k.Type, k.Version, zero)
+		}
+	}
+
+	for _, codec := range []Codec{JSONCodec{}, ProtobufCodec{}} {
+		for _, k := range Registered() {
+			sample, ok := samples[k]
+			if !ok {
+				continue
+			}
+			t.Run(codec.ContentType()+"/"+k.Type, func(t *testing.T) {
+				env, err := NewEnvelope("booking-srv", sample, map[string]string{"x-request-id": "req-1"})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				data, err := codec.Marshal(env)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got, err := codec.Unmarshal(data)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got.EventID != env.EventID || got.Type != env.Type || got.SchemaVersion != env.SchemaVersion ||
+					got.Producer != env.Producer || !got.OccurredAt.Equal(env.OccurredAt) ||
+					!reflect.DeepEqual(got.TraceContext, env.TraceContext) {
+					t.Errorf("envelope header changed: got %+v, want %+v", got, env)
+				}
+
+				event, err := got.Decode()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(reflect.ValueOf(event).Elem().Interface(), sample) {
+					t.Errorf("payload changed: got %+v, want %+v", event, sample)
+				}
+			})
+		}
+	}
+}
+
+// protoSchemaOf describes a protobuf message in the terms of schemaOf.
+func protoSchemaOf(md protoreflect.MessageDescriptor) schema {
+	s := schema{}
+	collectProtoFields(s, "", md)
+	return s
+}
+
+func collectProtoFields(s schema, prefix string, md protoreflect.MessageDescriptor) {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		path := prefix + string(f.Name())
+		switch {
+		case f.IsMap():
+			s[path] = "object<" + protoType(f.MapValue()) + ">"
+		case f.IsList():
+			s[path] = "array<" + protoType(f) + ">"
+		case f.Kind() == protoreflect.MessageKind && f.Message().FullName() != "google.protobuf.Timestamp":
+			collectProtoFields(s, path+".", f.Message())
+		default:
+			s[path] = protoType(f)
+		}
+	}
+}
+
+func protoType(f protoreflect.FieldDescriptor) string {
+	switch f.Kind() {
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.BoolKind:
+		return "boolean"
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Uint32Kind, protoreflect.Uint64Kind,
+		protoreflect.Sint32Kind, protoreflect.Sint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return "integer"
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return "number"
+	case protoreflect.BytesKind:
+		return "raw"
+	case protoreflect.MessageKind:
+		if f.Message().FullName() == "google.protobuf.Timestamp" {
+			return "timestamp"
+		}
+		return string(f.Message().FullName())
+	default:
+		return f.Kind().String()
+	}
+}
+
+// TestProtobufFieldsMatch keeps the Go payloads and their protobuf messages
+// in step: the codec converts through protojson, which rejects a JSON field
+// the message lacks and silently zeroes one the Go struct lacks.
+func TestProtobufFieldsMatch(t *testing.T) {
+	for _, k := range Registered() {
+		event, _ := New(k.Type, k.Version)
+		payload, err := newProtoPayload(k.Type, k.Version)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		goFields := schemaOf(reflect.TypeOf(event).Elem())
+		protoFields := protoSchemaOf(payload.ProtoReflect().Descriptor())
+		for path, typ := range goFields {
+			if protoType, ok := protoFields[path]; !ok {
+				t.Errorf("%s v%d: field %s is missing from %s", k.Type, k.Version, path, payload.ProtoReflect().Descriptor().FullName())
+			} else if protoType != typ {
+				t.Errorf("%s v%d: field %s is %s in Go and %s in protobuf", k.Type, k.Version, path, typ, protoType)
+			}
+		}
+		for path := range protoFields {
+			if _, ok := goFields[path]; !ok {
+				t.Errorf("%s v%d: protobuf field %s is missing from the Go payload", k.Type, k.Version, path)
+			}
+		}
+	}
+}
+
+func TestEveryRegisteredEventHasProtobufSchema(t *testing.T) {
+	for _, k := range Registered() {
+		if _, err := newProtoPayload(k.Type, k.Version); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestCodecFor(t *testing.T) {
+	for contentType, want := range map[string]string{
+		"":                  ContentTypeJSON,
+		ContentTypeJSON:     ContentTypeJSON,
+		ContentTypeProtobuf: ContentTypeProtobuf,
+	} {
+		codec, err := CodecFor(contentType)
+		if err != nil {
+			t.Fatalf("CodecFor(%q): %v", contentType, err)
+		}
+		if codec.ContentType() != want {
+			t.Errorf("CodecFor(%q) = %s, want %s", contentType, codec.ContentType(), want)
+		}
+	}
+
+	if _, err := CodecFor("text/xml"); err == nil {
+		t.Error("expected an error for an unsupported content type")
+	}
+}
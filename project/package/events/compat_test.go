@@ -0,0 +1,175 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "record new fields in testdata/schemas")
+
+// schema maps a JSON field path to its JSON type.
+type schema map[string]string
+
+func schemaOf(t reflect.Type) schema {
+	s := schema{}
+	collectFields(s, "", t)
+	return s
+}
+
+func collectFields(s schema, prefix string, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" || !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		path := prefix + name
+
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && ft != reflect.TypeOf(time.Time{}) {
+			collectFields(s, path+".", ft)
+			continue
+		}
+		s[path] = jsonType(ft)
+	}
+}
+
+func jsonType(t reflect.Type) string {
+	switch {
+	case t == reflect.TypeOf(time.Time{}):
+		return "timestamp"
+	case t == reflect.TypeOf(json.RawMessage{}):
+		return "raw"
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Map:
+		return "object<" + jsonType(t.Elem()) + ">"
+	case reflect.Slice, reflect.Array:
+		return "array<" + jsonType(t.Elem()) + ">"
+	default:
+		return t.Kind().String()
+	}
+}
+
+func schemaPath(name string) string {
+	return filepath.Join("testdata", "schemas", name+".json")
+}
+
+// checkCompatible fails when a recorded field was removed or changed its
+// type. New fields are additive and allowed; run with -update to record them.
+func checkCompatible(t *testing.T, name string, current schema) {
+	t.Helper()
+
+	data, err := os.ReadFile(schemaPath(name))
+	if os.IsNotExist(err) && *update {
+		writeSchema(t, name, current)
+		return
+	}
+	if err != nil {
+		t.Fatalf("no recorded schema for %s, run go test -run TestSchemaCompatibility -update: %v", name, err)
+	}
+
+	var recorded schema
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		t.Fatalf("failed to parse %s: %v", schemaPath(name), err)
+	}
+
+	compatible := true
+	for field, typ := range recorded {
+		got, ok := current[field]
+		switch {
+		case !ok:
+			t.Errorf("%s: field %q was removed; bump the schema version instead", name, field)
+			compatible = false
+		case got != typ:
+			t.Errorf("%s: field %q changed type from %s to %s; bump the schema version instead", name, field, typ, got)
+			compatible = false
+		}
+	}
+
+	var added []string
+	for field := range current {
+		if _, ok := recorded[field]; !ok {
+			added = append(added, field)
+		}
+	}
+	sort.Strings(added)
+	if len(added) > 0 && compatible {
+		if *update {
+			writeSchema(t, name, current)
+		} else {
+			t.Errorf("%s: new fields %v are not recorded, run go test -run TestSchemaCompatibility -update", name, added)
+		}
+	}
+}
+
+func writeSchema(t *testing.T, name string, s schema) {
+	t.Helper()
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(schemaPath(name), buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSchemaCompatibility(t *testing.T) {
+	checkCompatible(t, "envelope", schemaOf(reflect.TypeOf(Envelope{})))
+
+	recorded := map[string]bool{"envelope": true}
+	for _, k := range Registered() {
+		name := fmt.Sprintf("%s.v%d", k.Type, k.Version)
+		recorded[name] = true
+
+		event, _ := New(k.Type, k.Version)
+		checkCompatible(t, name, schemaOf(reflect.TypeOf(event).Elem()))
+	}
+
+	files, err := filepath.Glob(filepath.Join("testdata", "schemas", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		name := strings.TrimSuffix(filepath.Base(f), ".json")
+		if !recorded[name] {
+			t.Errorf("schema %s is recorded but no longer registered; consumers may still rely on it", name)
+		}
+	}
+}
+
+func TestRegisteredEventsMatchKey(t *testing.T) {
+	for _, k := range Registered() {
+		event, _ := New(k.Type, k.Version)
+		if event.EventType() != k.Type || event.SchemaVersion() != k.Version {
+			t.Errorf("registry key %s v%d builds %s v%d", k.Type, k.Version, event.EventType(), event.SchemaVersion())
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package events
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Event is implemented by every payload that travels inside an Envelope.
+type Event interface {
+	EventType() string
+	SchemaVersion() int
+}
+
+// Envelope is the common wrapper for every Kafka message. Data holds the
+// event payload whose shape is defined by Type and SchemaVersion.
+type Envelope struct {
+	EventID       string            `json:"event_id"`
+	Type          string            `json:"type"`
+	SchemaVersion int               `json:"schema_version"`
+	OccurredAt    time.Time         `json:"occurred_at"`
+	Producer      string            `json:"producer"`
+	TraceContext  map[string]string `json:"trace_context,omitempty"`
+	Data          json.RawMessage   `json:"data"`
+}
+
+// NewEnvelope wraps event with a fresh event ID and the current time.
+// traceContext is a propagation carrier, e.g. W3C traceparent and tracestate.
+func NewEnvelope(producer string, event Event, traceContext map[string]string) (*Envelope, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s event: %w", event.EventType(), err)
+	}
+
+	return &Envelope{
+		EventID:       newEventID(),
+		Type:          event.EventType(),
+		SchemaVersion: event.SchemaVersion(),
+		OccurredAt:    time.Now().UTC(),
+		Producer:      producer,
+		TraceContext:  traceContext,
+		Data:          data,
+	}, nil
+}
+
+// Decode unmarshals the envelope's data into the Go type registered for its
+// type and schema version.
+func (e *Envelope) Decode() (Event, error) {
+	event, ok := New(e.Type, e.SchemaVersion)
+	if !ok {
+		return nil, fmt.Errorf("unknown event %s v%d", e.Type, e.SchemaVersion)
+	}
+	if err := json.Unmarshal(e.Data, event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s v%d: %w", e.Type, e.SchemaVersion, err)
+	}
+	return event, nil
+}
+
+// newEventID returns a random RFC 4122 version 4 UUID.
+func newEventID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
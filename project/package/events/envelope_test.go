@@ -0,0 +1,76 @@
+package events
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+)
+
+func TestEnvelopeRoundTrip(t *testing.T) {
+	created := BookingCreatedEvent{
+		BookingID:    42,
+		UserEmail:    "ivan.ivanov@mail.ru",
+		UserName:     "Иван Иванов",
+		CheckInDate:  "2024-01-15",
+		CheckOutDate: "2024-01-20",
+		Amount:       500,
+	}
+
+	env, err := NewEnvelope("booking-srv", created, map[string]string{"traceparent": "00-abc-def-01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Envelope
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Type != TypeBookingCreated || decoded.SchemaVersion != 1 || decoded.Producer != "booking-srv" {
+		t.Fatalf("unexpected envelope header: %+v", decoded)
+	}
+	if decoded.TraceContext["traceparent"] != "00-abc-def-01" {
+		t.Errorf("trace context lost: %v", decoded.TraceContext)
+	}
+	if decoded.OccurredAt.IsZero() {
+		t.Error("occurred_at is not set")
+	}
+
+	event, err := decoded.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, ok := event.(*BookingCreatedEvent)
+	if !ok {
+		t.Fatalf("decoded %T, want *BookingCreatedEvent", event)
+	}
+	if *got != created {
+		t.Errorf("got %+v, want %+v", *got, created)
+	}
+}
+
+func TestEnvelopeDecodeUnknownVersion(t *testing.T) {
+	env := Envelope{Type: TypeBookingCreated, SchemaVersion: 99, Data: json.RawMessage(`{}`)}
+	if _, err := env.Decode(); err == nil {
+		t.Fatal("expected an error for an unregistered schema version")
+	}
+}
+
+func TestNewEventIDIsUUIDv4(t *testing.T) {
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		id := newEventID()
+		if !uuid.MatchString(id) {
+			t.Fatalf("%q is not a v4 UUID", id)
+		}
+		if seen[id] {
+			t.Fatalf("duplicate event id %q", id)
+		}
+		seen[id] = true
+	}
+}
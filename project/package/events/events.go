@@ -0,0 +1,113 @@
+package events
+
+// Event types, used as the envelope type and the Kafka topic.
+const (
+	TypeBookingCreated    = "booking-created"
+	TypeBookingCancelled  = "booking-cancelled"
+	TypeBookingModified   = "booking-modified"
+	TypeBookingCheckedIn  = "booking-checked-in"
+	TypeBookingCheckedOut = "booking-checked-out"
+	TypeBookingNoShow     = "booking-no-show"
+
+	TypeRoomPriceChanged = "hotel-room-price-changed"
+	TypeRoomsChanged     = "hotel-rooms-changed"
+)
+
+type BookingCreatedEvent struct {
+	BookingID    int     `json:"booking_id"`
+	UserEmail    string  `json:"user_email"`
+	UserName     string  `json:"user_name"`
+	HotelName    string  `json:"hotel_name"`
+	CheckInDate  string  `json:"check_in_date"`
+	CheckOutDate string  `json:"check_out_date"`
+	Amount       float64 `json:"amount"`
+	HotelAddress string  `json:"hotel_address"`
+	HotelPhone   string  `json:"hotel_phone"`
+	RoomTypeName string  `json:"room_type_name"`
+}
+
+func (BookingCreatedEvent) EventType() string  { return TypeBookingCreated }
+func (BookingCreatedEvent) SchemaVersion() int { return 1 }
+
+type BookingNoShowEvent struct {
+	BookingID    int     `json:"booking_id"`
+	UserID       int     `json:"user_id"`
+	HotelID      int     `json:"hotel_id"`
+	RoomID       int     `json:"room_id"`
+	CheckInDate  string  `json:"check_in_date"`
+	CheckOutDate string  `json:"check_out_date"`
+	Penalty      float64 `json:"penalty"`
+	UserEmail    string  `json:"user_email"`
+	UserName     string  `json:"user_name"`
+}
+
+func (BookingNoShowEvent) EventType() string  { return TypeBookingNoShow }
+func (BookingNoShowEvent) SchemaVersion() int { return 1 }
+
+type BookingCancelledEvent struct {
+	BookingID      int     `json:"booking_id"`
+	UserID         int     `json:"user_id"`
+	UserEmail      string  `json:"user_email"`
+	UserName       string  `json:"user_name"`
+	HotelID        int     `json:"hotel_id"`
+	RoomID         int     `json:"room_id"`
+	CheckInDate    string  `json:"check_in_date"`
+	CheckOutDate   string  `json:"check_out_date"`
+	TotalPrice     float64 `json:"total_price"`
+	PreviousStatus string  `json:"previous_status"`
+}
+
+func (BookingCancelledEvent) EventType() string  { return TypeBookingCancelled }
+func (BookingCancelledEvent) SchemaVersion() int { return 1 }
+
+// BookingTerms are the parts of a booking a guest can change.
+type BookingTerms struct {
+	CheckInDate  string  `json:"check_in_date"`
+	CheckOutDate string  `json:"check_out_date"`
+	GuestsCount  int     `json:"guests_count"`
+	TotalPrice   float64 `json:"total_price"`
+}
+
+type BookingModifiedEvent struct {
+	BookingID int          `json:"booking_id"`
+	UserID    int          `json:"user_id"`
+	UserEmail string       `json:"user_email"`
+	UserName  string       `json:"user_name"`
+	HotelID   int          `json:"hotel_id"`
+	RoomID    int          `json:"room_id"`
+	Before    BookingTerms `json:"before"`
+	After     BookingTerms `json:"after"`
+}
+
+func (BookingModifiedEvent) EventType() string  { return TypeBookingModified }
+func (BookingModifiedEvent) SchemaVersion() int { return 1 }
+
+type BookingCheckedInEvent struct {
+	BookingID    int    `json:"booking_id"`
+	UserID       int    `json:"user_id"`
+	UserEmail    string `json:"user_email"`
+	UserName     string `json:"user_name"`
+	HotelID      int    `json:"hotel_id"`
+	RoomID       int    `json:"room_id"`
+	CheckInDate  string `json:"check_in_date"`
+	CheckOutDate string `json:"check_out_date"`
+}
+
+func (BookingCheckedInEvent) EventType() string  { return TypeBookingCheckedIn }
+func (BookingCheckedInEvent) SchemaVersion() int { return 1 }
+
+type BookingCheckedOutEvent struct {
+	BookingID    int     `json:"booking_id"`
+	UserID       int     `json:"user_id"`
+	UserEmail    string  `json:"user_email"`
+	UserName     string  `json:"user_name"`
+	HotelID      int     `json:"hotel_id"`
+	RoomID       int     `json:"room_id"`
+	CheckInDate  string  `json:"check_in_date"`
+	CheckOutDate string  `json:"check_out_date"`
+	TotalPrice   float64 `json:"total_price"`
+	PointsEarned int     `json:"points_earned"`
+}
+
+func (BookingCheckedOutEvent) EventType() string  { return TypeBookingCheckedOut }
+func (BookingCheckedOutEvent) SchemaVersion() int { return 1 }
@@ -0,0 +1,23 @@
+package events
+
+// RoomPriceChangedEvent is published by hotel-srv when the nightly price of
+// a room type changes.
+type RoomPriceChangedEvent struct {
+	HotelID    int     `json:"hotel_id"`
+	RoomTypeID int     `json:"room_type_id"`
+	Price      float64 `json:"price"`
+}
+
+func (RoomPriceChangedEvent) EventType() string  { return TypeRoomPriceChanged }
+func (RoomPriceChangedEvent) SchemaVersion() int { return 1 }
+
+// RoomsChangedEvent is published by hotel-srv when rooms are added to a room
+// type. RoomIDs lists all rooms of the type after the change.
+type RoomsChangedEvent struct {
+	HotelID    int   `json:"hotel_id"`
+	RoomTypeID int   `json:"room_type_id"`
+	RoomIDs    []int `json:"room_ids"`
+}
+
+func (RoomsChangedEvent) EventType() string  { return TypeRoomsChanged }
+func (RoomsChangedEvent) SchemaVersion() int { return 1 }
@@ -0,0 +1,47 @@
+package events
+
+import "sort"
+
+// Key identifies one schema version of an event type.
+type Key struct {
+	Type    string
+	Version int
+}
+
+// registry lists every event schema that producers may emit. A breaking
+// change to a payload needs a new version here instead of editing the old
+// struct, see TestSchemaCompatibility.
+var registry = map[Key]func() Event{
+	{TypeBookingCreated, 1}:    func() Event { return &BookingCreatedEvent{} },
+	{TypeBookingCancelled, 1}:  func() Event { return &BookingCancelledEvent{} },
+	{TypeBookingModified, 1}:   func() Event { return &BookingModifiedEvent{} },
+	{TypeBookingCheckedIn, 1}:  func() Event { return &BookingCheckedInEvent{} },
+	{TypeBookingCheckedOut, 1}: func() Event { return &BookingCheckedOutEvent{} },
+	{TypeBookingNoShow, 1}:     func() Event { return &BookingNoShowEvent{} },
+	{TypeRoomPriceChanged, 1}:  func() Event { return &RoomPriceChangedEvent{} },
+	{TypeRoomsChanged, 1}:      func() Event { return &RoomsChangedEvent{} },
+}
+
+// New returns a pointer to a zero payload for the given type and version.
+func New(eventType string, version int) (Event, bool) {
+	factory, ok := registry[Key{eventType, version}]
+	if !ok {
+		return nil, false
+	}
+	return factory(), true
+}
+
+// Registered returns all known schemas ordered by type and version.
+func Registered() []Key {
+	keys := make([]Key, 0, len(registry))
+	for k := range registry {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Type != keys[j].Type {
+			return keys[i].Type < keys[j].Type
+		}
+		return keys[i].Version < keys[j].Version
+	})
+	return keys
+}
@@ -0,0 +1,763 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: package/proto/booking/stable/booking.proto
+
+package bookingstable
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Booking struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HotelId         int32                  `protobuf:"varint,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId          int32                  `protobuf:"varint,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	CheckInDate     string                 `protobuf:"bytes,5,opt,name=check_in_date,json=checkInDate,proto3" json:"check_in_date,omitempty"`
+	CheckOutDate    string                 `protobuf:"bytes,6,opt,name=check_out_date,json=checkOutDate,proto3" json:"check_out_date,omitempty"`
+	GuestsCount     int32                  `protobuf:"varint,7,opt,name=guests_count,json=guestsCount,proto3" json:"guests_count,omitempty"`
+	TotalPrice      float64                `protobuf:"fixed64,8,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	LoyaltyDiscount float64                `protobuf:"fixed64,9,opt,name=loyalty_discount,json=loyaltyDiscount,proto3" json:"loyalty_discount,omitempty"`
+	Status          string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	HotelName       string                 `protobuf:"bytes,11,opt,name=hotel_name,json=hotelName,proto3" json:"hotel_name,omitempty"`
+	HotelAddress    string                 `protobuf:"bytes,12,opt,name=hotel_address,json=hotelAddress,proto3" json:"hotel_address,omitempty"`
+	HotelPhone      string                 `protobuf:"bytes,13,opt,name=hotel_phone,json=hotelPhone,proto3" json:"hotel_phone,omitempty"`
+	RoomTypeName    string                 `protobuf:"bytes,14,opt,name=room_type_name,json=roomTypeName,proto3" json:"room_type_name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_package_proto_booking_stable_booking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Booking) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Booking) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Booking) GetHotelId() int32 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *Booking) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *Booking) GetCheckInDate() string {
+	if x != nil {
+		return x.CheckInDate
+	}
+	return ""
+}
+
+func (x *Booking) GetCheckOutDate() string {
+	if x != nil {
+		return x.CheckOutDate
+	}
+	return ""
+}
+
+func (x *Booking) GetGuestsCount() int32 {
+	if x != nil {
+		return x.GuestsCount
+	}
+	return 0
+}
+
+func (x *Booking) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *Booking) GetLoyaltyDiscount() float64 {
+	if x != nil {
+		return x.LoyaltyDiscount
+	}
+	return 0
+}
+
+func (x *Booking) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Booking) GetHotelName() string {
+	if x != nil {
+		return x.HotelName
+	}
+	return ""
+}
+
+func (x *Booking) GetHotelAddress() string {
+	if x != nil {
+		return x.HotelAddress
+	}
+	return ""
+}
+
+func (x *Booking) GetHotelPhone() string {
+	if x != nil {
+		return x.HotelPhone
+	}
+	return ""
+}
+
+func (x *Booking) GetRoomTypeName() string {
+	if x != nil {
+		return x.RoomTypeName
+	}
+	return ""
+}
+
+type CreateBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HotelId       int32                  `protobuf:"varint,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomTypeId    int32                  `protobuf:"varint,3,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	CheckInDate   string                 `protobuf:"bytes,4,opt,name=check_in_date,json=checkInDate,proto3" json:"check_in_date,omitempty"`
+	CheckOutDate  string                 `protobuf:"bytes,5,opt,name=check_out_date,json=checkOutDate,proto3" json:"check_out_date,omitempty"`
+	GuestsCount   int32                  `protobuf:"varint,6,opt,name=guests_count,json=guestsCount,proto3" json:"guests_count,omitempty"`
+	LoyaltyPoints int32                  `protobuf:"varint,7,opt,name=loyalty_points,json=loyaltyPoints,proto3" json:"loyalty_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_package_proto_booking_stable_booking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateBookingRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateBookingRequest) GetHotelId() int32 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *CreateBookingRequest) GetRoomTypeId() int32 {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return 0
+}
+
+func (x *CreateBookingRequest) GetCheckInDate() string {
+	if x != nil {
+		return x.CheckInDate
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetCheckOutDate() string {
+	if x != nil {
+		return x.CheckOutDate
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetGuestsCount() int32 {
+	if x != nil {
+		return x.GuestsCount
+	}
+	return 0
+}
+
+func (x *CreateBookingRequest) GetLoyaltyPoints() int32 {
+	if x != nil {
+		return x.LoyaltyPoints
+	}
+	return 0
+}
+
+type CreateBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingResponse) Reset() {
+	*x = CreateBookingResponse{}
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingResponse) ProtoMessage() {}
+
+func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
+	return file_package_proto_booking_stable_booking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateBookingResponse) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *CreateBookingResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingRequest) ProtoMessage() {}
+
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+	return file_package_proto_booking_stable_booking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type GetBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingResponse) Reset() {
+	*x = GetBookingResponse{}
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingResponse) ProtoMessage() {}
+
+func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingResponse) Descriptor() ([]byte, []int) {
+	return file_package_proto_booking_stable_booking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type ListUserBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserBookingsRequest) Reset() {
+	*x = ListUserBookingsRequest{}
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserBookingsRequest) ProtoMessage() {}
+
+func (x *ListUserBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_package_proto_booking_stable_booking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListUserBookingsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListHotelBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       int32                  `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHotelBookingsRequest) Reset() {
+	*x = ListHotelBookingsRequest{}
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHotelBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHotelBookingsRequest) ProtoMessage() {}
+
+func (x *ListHotelBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHotelBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListHotelBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_package_proto_booking_stable_booking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListHotelBookingsRequest) GetHotelId() int32 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+type ListBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingsResponse) Reset() {
+	*x = ListBookingsResponse{}
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsResponse) ProtoMessage() {}
+
+func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_package_proto_booking_stable_booking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_package_proto_booking_stable_booking_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_booking_stable_booking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_package_proto_booking_stable_booking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelBookingResponse) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *CancelBookingResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+var File_package_proto_booking_stable_booking_proto protoreflect.FileDescriptor
+
+const file_package_proto_booking_stable_booking_proto_rawDesc = "" +
+	"\n" +
+	"*package/proto/booking/stable/booking.proto\x12\n" +
+	"booking.v1\"\xc2\x03\n" +
+	"\aBooking\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bhotel_id\x18\x03 \x01(\x05R\ahotelId\x12\x17\n" +
+	"\aroom_id\x18\x04 \x01(\x05R\x06roomId\x12\"\n" +
+	"\rcheck_in_date\x18\x05 \x01(\tR\vcheckInDate\x12$\n" +
+	"\x0echeck_out_date\x18\x06 \x01(\tR\fcheckOutDate\x12!\n" +
+	"\fguests_count\x18\a \x01(\x05R\vguestsCount\x12\x1f\n" +
+	"\vtotal_price\x18\b \x01(\x01R\n" +
+	"totalPrice\x12)\n" +
+	"\x10loyalty_discount\x18\t \x01(\x01R\x0floyaltyDiscount\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"hotel_name\x18\v \x01(\tR\thotelName\x12#\n" +
+	"\rhotel_address\x18\f \x01(\tR\fhotelAddress\x12\x1f\n" +
+	"\vhotel_phone\x18\r \x01(\tR\n" +
+	"hotelPhone\x12$\n" +
+	"\x0eroom_type_name\x18\x0e \x01(\tR\froomTypeName\"\x80\x02\n" +
+	"\x14CreateBookingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bhotel_id\x18\x02 \x01(\x05R\ahotelId\x12 \n" +
+	"\froom_type_id\x18\x03 \x01(\x05R\n" +
+	"roomTypeId\x12\"\n" +
+	"\rcheck_in_date\x18\x04 \x01(\tR\vcheckInDate\x12$\n" +
+	"\x0echeck_out_date\x18\x05 \x01(\tR\fcheckOutDate\x12!\n" +
+	"\fguests_count\x18\x06 \x01(\x05R\vguestsCount\x12%\n" +
+	"\x0eloyalty_points\x18\a \x01(\x05R\rloyaltyPoints\"N\n" +
+	"\x15CreateBookingResponse\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"2\n" +
+	"\x11GetBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\"C\n" +
+	"\x12GetBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"2\n" +
+	"\x17ListUserBookingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"5\n" +
+	"\x18ListHotelBookingsRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x05R\ahotelId\"G\n" +
+	"\x14ListBookingsResponse\x12/\n" +
+	"\bbookings\x18\x01 \x03(\v2\x13.booking.v1.BookingR\bbookings\"5\n" +
+	"\x14CancelBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\"N\n" +
+	"\x15CancelBookingResponse\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status2\xc1\x03\n" +
+	"\x0eBookingService\x12T\n" +
+	"\rCreateBooking\x12 .booking.v1.CreateBookingRequest\x1a!.booking.v1.CreateBookingResponse\x12K\n" +
+	"\n" +
+	"GetBooking\x12\x1d.booking.v1.GetBookingRequest\x1a\x1e.booking.v1.GetBookingResponse\x12Y\n" +
+	"\x10ListUserBookings\x12#.booking.v1.ListUserBookingsRequest\x1a .booking.v1.ListBookingsResponse\x12[\n" +
+	"\x11ListHotelBookings\x12$.booking.v1.ListHotelBookingsRequest\x1a .booking.v1.ListBookingsResponse\x12T\n" +
+	"\rCancelBooking\x12 .booking.v1.CancelBookingRequest\x1a!.booking.v1.CancelBookingResponseBAZ?hotel-booking-system/package/proto/booking/stable;bookingstableb\x06proto3"
+
+var (
+	file_package_proto_booking_stable_booking_proto_rawDescOnce sync.Once
+	file_package_proto_booking_stable_booking_proto_rawDescData []byte
+)
+
+func file_package_proto_booking_stable_booking_proto_rawDescGZIP() []byte {
+	file_package_proto_booking_stable_booking_proto_rawDescOnce.Do(func() {
+		file_package_proto_booking_stable_booking_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_package_proto_booking_stable_booking_proto_rawDesc), len(file_package_proto_booking_stable_booking_proto_rawDesc)))
+	})
+	return file_package_proto_booking_stable_booking_proto_rawDescData
+}
+
+var file_package_proto_booking_stable_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_package_proto_booking_stable_booking_proto_goTypes = []any{
+	(*Booking)(nil),                  // 0: booking.v1.Booking
+	(*CreateBookingRequest)(nil),     // 1: booking.v1.CreateBookingRequest
+	(*CreateBookingResponse)(nil),    // 2: booking.v1.CreateBookingResponse
+	(*GetBookingRequest)(nil),        // 3: booking.v1.GetBookingRequest
+	(*GetBookingResponse)(nil),       // 4: booking.v1.GetBookingResponse
+	(*ListUserBookingsRequest)(nil),  // 5: booking.v1.ListUserBookingsRequest
+	(*ListHotelBookingsRequest)(nil), // 6: booking.v1.ListHotelBookingsRequest
+	(*ListBookingsResponse)(nil),     // 7: booking.v1.ListBookingsResponse
+	(*CancelBookingRequest)(nil),     // 8: booking.v1.CancelBookingRequest
+	(*CancelBookingResponse)(nil),    // 9: booking.v1.CancelBookingResponse
+}
+var file_package_proto_booking_stable_booking_proto_depIdxs = []int32{
+	0, // 0: booking.v1.GetBookingResponse.booking:type_name -> booking.v1.Booking
+	0, // 1: booking.v1.ListBookingsResponse.bookings:type_name -> booking.v1.Booking
+	1, // 2: booking.v1.BookingService.CreateBooking:input_type -> booking.v1.CreateBookingRequest
+	3, // 3: booking.v1.BookingService.GetBooking:input_type -> booking.v1.GetBookingRequest
+	5, // 4: booking.v1.BookingService.ListUserBookings:input_type -> booking.v1.ListUserBookingsRequest
+	6, // 5: booking.v1.BookingService.ListHotelBookings:input_type -> booking.v1.ListHotelBookingsRequest
+	8, // 6: booking.v1.BookingService.CancelBooking:input_type -> booking.v1.CancelBookingRequest
+	2, // 7: booking.v1.BookingService.CreateBooking:output_type -> booking.v1.CreateBookingResponse
+	4, // 8: booking.v1.BookingService.GetBooking:output_type -> booking.v1.GetBookingResponse
+	7, // 9: booking.v1.BookingService.ListUserBookings:output_type -> booking.v1.ListBookingsResponse
+	7, // 10: booking.v1.BookingService.ListHotelBookings:output_type -> booking.v1.ListBookingsResponse
+	9, // 11: booking.v1.BookingService.CancelBooking:output_type -> booking.v1.CancelBookingResponse
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_package_proto_booking_stable_booking_proto_init() }
+func file_package_proto_booking_stable_booking_proto_init() {
+	if File_package_proto_booking_stable_booking_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_package_proto_booking_stable_booking_proto_rawDesc), len(file_package_proto_booking_stable_booking_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_package_proto_booking_stable_booking_proto_goTypes,
+		DependencyIndexes: file_package_proto_booking_stable_booking_proto_depIdxs,
+		MessageInfos:      file_package_proto_booking_stable_booking_proto_msgTypes,
+	}.Build()
+	File_package_proto_booking_stable_booking_proto = out.File
+	file_package_proto_booking_stable_booking_proto_goTypes = nil
+	file_package_proto_booking_stable_booking_proto_depIdxs = nil
+}
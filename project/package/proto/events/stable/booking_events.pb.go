@@ -0,0 +1,1012 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: package/proto/events/stable/booking_events.proto
+
+package eventsstable
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventEnvelope mirrors events.Envelope. data holds the serialized payload
+// message selected by type and schema_version.
+type EventEnvelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	SchemaVersion int32                  `protobuf:"varint,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Producer      string                 `protobuf:"bytes,5,opt,name=producer,proto3" json:"producer,omitempty"`
+	TraceContext  map[string]string      `protobuf:"bytes,6,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Data          []byte                 `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventEnvelope) Reset() {
+	*x = EventEnvelope{}
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventEnvelope) ProtoMessage() {}
+
+func (x *EventEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventEnvelope.ProtoReflect.Descriptor instead.
+func (*EventEnvelope) Descriptor() ([]byte, []int) {
+	return file_package_proto_events_stable_booking_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventEnvelope) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *EventEnvelope) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetProducer() string {
+	if x != nil {
+		return x.Producer
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetTraceContext() map[string]string {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// booking-created v1
+type BookingCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,2,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	HotelName     string                 `protobuf:"bytes,4,opt,name=hotel_name,json=hotelName,proto3" json:"hotel_name,omitempty"`
+	CheckInDate   string                 `protobuf:"bytes,5,opt,name=check_in_date,json=checkInDate,proto3" json:"check_in_date,omitempty"`
+	CheckOutDate  string                 `protobuf:"bytes,6,opt,name=check_out_date,json=checkOutDate,proto3" json:"check_out_date,omitempty"`
+	Amount        float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	HotelAddress  string                 `protobuf:"bytes,8,opt,name=hotel_address,json=hotelAddress,proto3" json:"hotel_address,omitempty"`
+	HotelPhone    string                 `protobuf:"bytes,9,opt,name=hotel_phone,json=hotelPhone,proto3" json:"hotel_phone,omitempty"`
+	RoomTypeName  string                 `protobuf:"bytes,10,opt,name=room_type_name,json=roomTypeName,proto3" json:"room_type_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingCreated) Reset() {
+	*x = BookingCreated{}
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingCreated) ProtoMessage() {}
+
+func (x *BookingCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingCreated.ProtoReflect.Descriptor instead.
+func (*BookingCreated) Descriptor() ([]byte, []int) {
+	return file_package_proto_events_stable_booking_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BookingCreated) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *BookingCreated) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetHotelName() string {
+	if x != nil {
+		return x.HotelName
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetCheckInDate() string {
+	if x != nil {
+		return x.CheckInDate
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetCheckOutDate() string {
+	if x != nil {
+		return x.CheckOutDate
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BookingCreated) GetHotelAddress() string {
+	if x != nil {
+		return x.HotelAddress
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetHotelPhone() string {
+	if x != nil {
+		return x.HotelPhone
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetRoomTypeName() string {
+	if x != nil {
+		return x.RoomTypeName
+	}
+	return ""
+}
+
+// booking-no-show v1
+type BookingNoShow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HotelId       int32                  `protobuf:"varint,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        int32                  `protobuf:"varint,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	CheckInDate   string                 `protobuf:"bytes,5,opt,name=check_in_date,json=checkInDate,proto3" json:"check_in_date,omitempty"`
+	CheckOutDate  string                 `protobuf:"bytes,6,opt,name=check_out_date,json=checkOutDate,proto3" json:"check_out_date,omitempty"`
+	Penalty       float64                `protobuf:"fixed64,7,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,8,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	UserName      string                 `protobuf:"bytes,9,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingNoShow) Reset() {
+	*x = BookingNoShow{}
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingNoShow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingNoShow) ProtoMessage() {}
+
+func (x *BookingNoShow) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingNoShow.ProtoReflect.Descriptor instead.
+func (*BookingNoShow) Descriptor() ([]byte, []int) {
+	return file_package_proto_events_stable_booking_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BookingNoShow) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *BookingNoShow) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookingNoShow) GetHotelId() int32 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *BookingNoShow) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *BookingNoShow) GetCheckInDate() string {
+	if x != nil {
+		return x.CheckInDate
+	}
+	return ""
+}
+
+func (x *BookingNoShow) GetCheckOutDate() string {
+	if x != nil {
+		return x.CheckOutDate
+	}
+	return ""
+}
+
+func (x *BookingNoShow) GetPenalty() float64 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+func (x *BookingNoShow) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *BookingNoShow) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+// booking-cancelled v1
+type BookingCancelled struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookingId      int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId         int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail      string                 `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	UserName       string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	HotelId        int32                  `protobuf:"varint,5,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId         int32                  `protobuf:"varint,6,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	CheckInDate    string                 `protobuf:"bytes,7,opt,name=check_in_date,json=checkInDate,proto3" json:"check_in_date,omitempty"`
+	CheckOutDate   string                 `protobuf:"bytes,8,opt,name=check_out_date,json=checkOutDate,proto3" json:"check_out_date,omitempty"`
+	TotalPrice     float64                `protobuf:"fixed64,9,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	PreviousStatus string                 `protobuf:"bytes,10,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BookingCancelled) Reset() {
+	*x = BookingCancelled{}
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingCancelled) ProtoMessage() {}
+
+func (x *BookingCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingCancelled.ProtoReflect.Descriptor instead.
+func (*BookingCancelled) Descriptor() ([]byte, []int) {
+	return file_package_proto_events_stable_booking_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BookingCancelled) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *BookingCancelled) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookingCancelled) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetHotelId() int32 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *BookingCancelled) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *BookingCancelled) GetCheckInDate() string {
+	if x != nil {
+		return x.CheckInDate
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetCheckOutDate() string {
+	if x != nil {
+		return x.CheckOutDate
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *BookingCancelled) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+type BookingTerms struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckInDate   string                 `protobuf:"bytes,1,opt,name=check_in_date,json=checkInDate,proto3" json:"check_in_date,omitempty"`
+	CheckOutDate  string                 `protobuf:"bytes,2,opt,name=check_out_date,json=checkOutDate,proto3" json:"check_out_date,omitempty"`
+	GuestsCount   int32                  `protobuf:"varint,3,opt,name=guests_count,json=guestsCount,proto3" json:"guests_count,omitempty"`
+	TotalPrice    float64                `protobuf:"fixed64,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingTerms) Reset() {
+	*x = BookingTerms{}
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingTerms) ProtoMessage() {}
+
+func (x *BookingTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingTerms.ProtoReflect.Descriptor instead.
+func (*BookingTerms) Descriptor() ([]byte, []int) {
+	return file_package_proto_events_stable_booking_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BookingTerms) GetCheckInDate() string {
+	if x != nil {
+		return x.CheckInDate
+	}
+	return ""
+}
+
+func (x *BookingTerms) GetCheckOutDate() string {
+	if x != nil {
+		return x.CheckOutDate
+	}
+	return ""
+}
+
+func (x *BookingTerms) GetGuestsCount() int32 {
+	if x != nil {
+		return x.GuestsCount
+	}
+	return 0
+}
+
+func (x *BookingTerms) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+// booking-modified v1
+type BookingModified struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	HotelId       int32                  `protobuf:"varint,5,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        int32                  `protobuf:"varint,6,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Before        *BookingTerms          `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After         *BookingTerms          `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingModified) Reset() {
+	*x = BookingModified{}
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingModified) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingModified) ProtoMessage() {}
+
+func (x *BookingModified) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingModified.ProtoReflect.Descriptor instead.
+func (*BookingModified) Descriptor() ([]byte, []int) {
+	return file_package_proto_events_stable_booking_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BookingModified) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *BookingModified) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookingModified) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *BookingModified) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *BookingModified) GetHotelId() int32 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *BookingModified) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *BookingModified) GetBefore() *BookingTerms {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *BookingModified) GetAfter() *BookingTerms {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// booking-checked-in v1
+type BookingCheckedIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	HotelId       int32                  `protobuf:"varint,5,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        int32                  `protobuf:"varint,6,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	CheckInDate   string                 `protobuf:"bytes,7,opt,name=check_in_date,json=checkInDate,proto3" json:"check_in_date,omitempty"`
+	CheckOutDate  string                 `protobuf:"bytes,8,opt,name=check_out_date,json=checkOutDate,proto3" json:"check_out_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingCheckedIn) Reset() {
+	*x = BookingCheckedIn{}
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingCheckedIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingCheckedIn) ProtoMessage() {}
+
+func (x *BookingCheckedIn) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingCheckedIn.ProtoReflect.Descriptor instead.
+func (*BookingCheckedIn) Descriptor() ([]byte, []int) {
+	return file_package_proto_events_stable_booking_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BookingCheckedIn) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *BookingCheckedIn) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookingCheckedIn) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *BookingCheckedIn) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *BookingCheckedIn) GetHotelId() int32 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *BookingCheckedIn) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *BookingCheckedIn) GetCheckInDate() string {
+	if x != nil {
+		return x.CheckInDate
+	}
+	return ""
+}
+
+func (x *BookingCheckedIn) GetCheckOutDate() string {
+	if x != nil {
+		return x.CheckOutDate
+	}
+	return ""
+}
+
+// booking-checked-out v1
+type BookingCheckedOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	HotelId       int32                  `protobuf:"varint,5,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        int32                  `protobuf:"varint,6,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	CheckInDate   string                 `protobuf:"bytes,7,opt,name=check_in_date,json=checkInDate,proto3" json:"check_in_date,omitempty"`
+	CheckOutDate  string                 `protobuf:"bytes,8,opt,name=check_out_date,json=checkOutDate,proto3" json:"check_out_date,omitempty"`
+	TotalPrice    float64                `protobuf:"fixed64,9,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	PointsEarned  int32                  `protobuf:"varint,10,opt,name=points_earned,json=pointsEarned,proto3" json:"points_earned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingCheckedOut) Reset() {
+	*x = BookingCheckedOut{}
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingCheckedOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingCheckedOut) ProtoMessage() {}
+
+func (x *BookingCheckedOut) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_events_stable_booking_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingCheckedOut.ProtoReflect.Descriptor instead.
+func (*BookingCheckedOut) Descriptor() ([]byte, []int) {
+	return file_package_proto_events_stable_booking_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BookingCheckedOut) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *BookingCheckedOut) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookingCheckedOut) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *BookingCheckedOut) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *BookingCheckedOut) GetHotelId() int32 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *BookingCheckedOut) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *BookingCheckedOut) GetCheckInDate() string {
+	if x != nil {
+		return x.CheckInDate
+	}
+	return ""
+}
+
+func (x *BookingCheckedOut) GetCheckOutDate() string {
+	if x != nil {
+		return x.CheckOutDate
+	}
+	return ""
+}
+
+func (x *BookingCheckedOut) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *BookingCheckedOut) GetPointsEarned() int32 {
+	if x != nil {
+		return x.PointsEarned
+	}
+	return 0
+}
+
+var File_package_proto_events_stable_booking_events_proto protoreflect.FileDescriptor
+
+const file_package_proto_events_stable_booking_events_proto_rawDesc = "" +
+	"\n" +
+	"0package/proto/events/stable/booking_events.proto\x12\tevents.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe4\x02\n" +
+	"\rEventEnvelope\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\x05R\rschemaVersion\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x1a\n" +
+	"\bproducer\x18\x05 \x01(\tR\bproducer\x12O\n" +
+	"\rtrace_context\x18\x06 \x03(\v2*.events.v1.EventEnvelope.TraceContextEntryR\ftraceContext\x12\x12\n" +
+	"\x04data\x18\a \x01(\fR\x04data\x1a?\n" +
+	"\x11TraceContextEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd8\x02\n" +
+	"\x0eBookingCreated\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x02 \x01(\tR\tuserEmail\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"hotel_name\x18\x04 \x01(\tR\thotelName\x12\"\n" +
+	"\rcheck_in_date\x18\x05 \x01(\tR\vcheckInDate\x12$\n" +
+	"\x0echeck_out_date\x18\x06 \x01(\tR\fcheckOutDate\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12#\n" +
+	"\rhotel_address\x18\b \x01(\tR\fhotelAddress\x12\x1f\n" +
+	"\vhotel_phone\x18\t \x01(\tR\n" +
+	"hotelPhone\x12$\n" +
+	"\x0eroom_type_name\x18\n" +
+	" \x01(\tR\froomTypeName\"\x9b\x02\n" +
+	"\rBookingNoShow\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bhotel_id\x18\x03 \x01(\x05R\ahotelId\x12\x17\n" +
+	"\aroom_id\x18\x04 \x01(\x05R\x06roomId\x12\"\n" +
+	"\rcheck_in_date\x18\x05 \x01(\tR\vcheckInDate\x12$\n" +
+	"\x0echeck_out_date\x18\x06 \x01(\tR\fcheckOutDate\x12\x18\n" +
+	"\apenalty\x18\a \x01(\x01R\apenalty\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\b \x01(\tR\tuserEmail\x12\x1b\n" +
+	"\tuser_name\x18\t \x01(\tR\buserName\"\xce\x02\n" +
+	"\x10BookingCancelled\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x03 \x01(\tR\tuserEmail\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\x12\x19\n" +
+	"\bhotel_id\x18\x05 \x01(\x05R\ahotelId\x12\x17\n" +
+	"\aroom_id\x18\x06 \x01(\x05R\x06roomId\x12\"\n" +
+	"\rcheck_in_date\x18\a \x01(\tR\vcheckInDate\x12$\n" +
+	"\x0echeck_out_date\x18\b \x01(\tR\fcheckOutDate\x12\x1f\n" +
+	"\vtotal_price\x18\t \x01(\x01R\n" +
+	"totalPrice\x12'\n" +
+	"\x0fprevious_status\x18\n" +
+	" \x01(\tR\x0epreviousStatus\"\x9c\x01\n" +
+	"\fBookingTerms\x12\"\n" +
+	"\rcheck_in_date\x18\x01 \x01(\tR\vcheckInDate\x12$\n" +
+	"\x0echeck_out_date\x18\x02 \x01(\tR\fcheckOutDate\x12!\n" +
+	"\fguests_count\x18\x03 \x01(\x05R\vguestsCount\x12\x1f\n" +
+	"\vtotal_price\x18\x04 \x01(\x01R\n" +
+	"totalPrice\"\x99\x02\n" +
+	"\x0fBookingModified\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x03 \x01(\tR\tuserEmail\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\x12\x19\n" +
+	"\bhotel_id\x18\x05 \x01(\x05R\ahotelId\x12\x17\n" +
+	"\aroom_id\x18\x06 \x01(\x05R\x06roomId\x12/\n" +
+	"\x06before\x18\a \x01(\v2\x17.events.v1.BookingTermsR\x06before\x12-\n" +
+	"\x05after\x18\b \x01(\v2\x17.events.v1.BookingTermsR\x05after\"\x84\x02\n" +
+	"\x10BookingCheckedIn\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x03 \x01(\tR\tuserEmail\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\x12\x19\n" +
+	"\bhotel_id\x18\x05 \x01(\x05R\ahotelId\x12\x17\n" +
+	"\aroom_id\x18\x06 \x01(\x05R\x06roomId\x12\"\n" +
+	"\rcheck_in_date\x18\a \x01(\tR\vcheckInDate\x12$\n" +
+	"\x0echeck_out_date\x18\b \x01(\tR\fcheckOutDate\"\xcb\x02\n" +
+	"\x11BookingCheckedOut\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x03 \x01(\tR\tuserEmail\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\x12\x19\n" +
+	"\bhotel_id\x18\x05 \x01(\x05R\ahotelId\x12\x17\n" +
+	"\aroom_id\x18\x06 \x01(\x05R\x06roomId\x12\"\n" +
+	"\rcheck_in_date\x18\a \x01(\tR\vcheckInDate\x12$\n" +
+	"\x0echeck_out_date\x18\b \x01(\tR\fcheckOutDate\x12\x1f\n" +
+	"\vtotal_price\x18\t \x01(\x01R\n" +
+	"totalPrice\x12#\n" +
+	"\rpoints_earned\x18\n" +
+	" \x01(\x05R\fpointsEarnedB?Z=hotel-booking-system/package/proto/events/stable;eventsstableb\x06proto3"
+
+var (
+	file_package_proto_events_stable_booking_events_proto_rawDescOnce sync.Once
+	file_package_proto_events_stable_booking_events_proto_rawDescData []byte
+)
+
+func file_package_proto_events_stable_booking_events_proto_rawDescGZIP() []byte {
+	file_package_proto_events_stable_booking_events_proto_rawDescOnce.Do(func() {
+		file_package_proto_events_stable_booking_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_package_proto_events_stable_booking_events_proto_rawDesc), len(file_package_proto_events_stable_booking_events_proto_rawDesc)))
+	})
+	return file_package_proto_events_stable_booking_events_proto_rawDescData
+}
+
+var file_package_proto_events_stable_booking_events_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_package_proto_events_stable_booking_events_proto_goTypes = []any{
+	(*EventEnvelope)(nil),         // 0: events.v1.EventEnvelope
+	(*BookingCreated)(nil),        // 1: events.v1.BookingCreated
+	(*BookingNoShow)(nil),         // 2: events.v1.BookingNoShow
+	(*BookingCancelled)(nil),      // 3: events.v1.BookingCancelled
+	(*BookingTerms)(nil),          // 4: events.v1.BookingTerms
+	(*BookingModified)(nil),       // 5: events.v1.BookingModified
+	(*BookingCheckedIn)(nil),      // 6: events.v1.BookingCheckedIn
+	(*BookingCheckedOut)(nil),     // 7: events.v1.BookingCheckedOut
+	nil,                           // 8: events.v1.EventEnvelope.TraceContextEntry
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+}
+var file_package_proto_events_stable_booking_events_proto_depIdxs = []int32{
+	9, // 0: events.v1.EventEnvelope.occurred_at:type_name -> google.protobuf.Timestamp
+	8, // 1: events.v1.EventEnvelope.trace_context:type_name -> events.v1.EventEnvelope.TraceContextEntry
+	4, // 2: events.v1.BookingModified.before:type_name -> events.v1.BookingTerms
+	4, // 3: events.v1.BookingModified.after:type_name -> events.v1.BookingTerms
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_package_proto_events_stable_booking_events_proto_init() }
+func file_package_proto_events_stable_booking_events_proto_init() {
+	if File_package_proto_events_stable_booking_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_package_proto_events_stable_booking_events_proto_rawDesc), len(file_package_proto_events_stable_booking_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_package_proto_events_stable_booking_events_proto_goTypes,
+		DependencyIndexes: file_package_proto_events_stable_booking_events_proto_depIdxs,
+		MessageInfos:      file_package_proto_events_stable_booking_events_proto_msgTypes,
+	}.Build()
+	File_package_proto_events_stable_booking_events_proto = out.File
+	file_package_proto_events_stable_booking_events_proto_goTypes = nil
+	file_package_proto_events_stable_booking_events_proto_depIdxs = nil
+}
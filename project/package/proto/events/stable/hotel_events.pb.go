@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: package/proto/events/stable/hotel_events.proto
+
+package eventsstable
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// hotel-room-price-changed v1
+type RoomPriceChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       int32                  `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomTypeId    int32                  `protobuf:"varint,2,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomPriceChanged) Reset() {
+	*x = RoomPriceChanged{}
+	mi := &file_package_proto_events_stable_hotel_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomPriceChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomPriceChanged) ProtoMessage() {}
+
+func (x *RoomPriceChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_events_stable_hotel_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomPriceChanged.ProtoReflect.Descriptor instead.
+func (*RoomPriceChanged) Descriptor() ([]byte, []int) {
+	return file_package_proto_events_stable_hotel_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoomPriceChanged) GetHotelId() int32 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *RoomPriceChanged) GetRoomTypeId() int32 {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return 0
+}
+
+func (x *RoomPriceChanged) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// hotel-rooms-changed v1
+type RoomsChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       int32                  `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomTypeId    int32                  `protobuf:"varint,2,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	RoomIds       []int32                `protobuf:"varint,3,rep,packed,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomsChanged) Reset() {
+	*x = RoomsChanged{}
+	mi := &file_package_proto_events_stable_hotel_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomsChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomsChanged) ProtoMessage() {}
+
+func (x *RoomsChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_events_stable_hotel_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomsChanged.ProtoReflect.Descriptor instead.
+func (*RoomsChanged) Descriptor() ([]byte, []int) {
+	return file_package_proto_events_stable_hotel_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RoomsChanged) GetHotelId() int32 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *RoomsChanged) GetRoomTypeId() int32 {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return 0
+}
+
+func (x *RoomsChanged) GetRoomIds() []int32 {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+var File_package_proto_events_stable_hotel_events_proto protoreflect.FileDescriptor
+
+const file_package_proto_events_stable_hotel_events_proto_rawDesc = "" +
+	"\n" +
+	".package/proto/events/stable/hotel_events.proto\x12\tevents.v1\"e\n" +
+	"\x10RoomPriceChanged\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x05R\ahotelId\x12 \n" +
+	"\froom_type_id\x18\x02 \x01(\x05R\n" +
+	"roomTypeId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\"f\n" +
+	"\fRoomsChanged\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x05R\ahotelId\x12 \n" +
+	"\froom_type_id\x18\x02 \x01(\x05R\n" +
+	"roomTypeId\x12\x19\n" +
+	"\broom_ids\x18\x03 \x03(\x05R\aroomIdsB?Z=hotel-booking-system/package/proto/events/stable;eventsstableb\x06proto3"
+
+var (
+	file_package_proto_events_stable_hotel_events_proto_rawDescOnce sync.Once
+	file_package_proto_events_stable_hotel_events_proto_rawDescData []byte
+)
+
+func file_package_proto_events_stable_hotel_events_proto_rawDescGZIP() []byte {
+	file_package_proto_events_stable_hotel_events_proto_rawDescOnce.Do(func() {
+		file_package_proto_events_stable_hotel_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_package_proto_events_stable_hotel_events_proto_rawDesc), len(file_package_proto_events_stable_hotel_events_proto_rawDesc)))
+	})
+	return file_package_proto_events_stable_hotel_events_proto_rawDescData
+}
+
+var file_package_proto_events_stable_hotel_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_package_proto_events_stable_hotel_events_proto_goTypes = []any{
+	(*RoomPriceChanged)(nil), // 0: events.v1.RoomPriceChanged
+	(*RoomsChanged)(nil),     // 1: events.v1.RoomsChanged
+}
+var file_package_proto_events_stable_hotel_events_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_package_proto_events_stable_hotel_events_proto_init() }
+func file_package_proto_events_stable_hotel_events_proto_init() {
+	if File_package_proto_events_stable_hotel_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_package_proto_events_stable_hotel_events_proto_rawDesc), len(file_package_proto_events_stable_hotel_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_package_proto_events_stable_hotel_events_proto_goTypes,
+		DependencyIndexes: file_package_proto_events_stable_hotel_events_proto_depIdxs,
+		MessageInfos:      file_package_proto_events_stable_hotel_events_proto_msgTypes,
+	}.Build()
+	File_package_proto_events_stable_hotel_events_proto = out.File
+	file_package_proto_events_stable_hotel_events_proto_goTypes = nil
+	file_package_proto_events_stable_hotel_events_proto_depIdxs = nil
+}
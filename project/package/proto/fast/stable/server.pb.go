@@ -221,6 +221,246 @@ func (x *GetRoomsIDResponse) GetRoomIds() []int32 {
 	return nil
 }
 
+type CreateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       int32                  `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	BookingId     int32                  `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_package_proto_fast_stable_server_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewRequest) ProtoMessage() {}
+
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_fast_stable_server_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_package_proto_fast_stable_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateReviewRequest) GetHotelId() int32 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type CreateReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int32                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewResponse) Reset() {
+	*x = CreateReviewResponse{}
+	mi := &file_package_proto_fast_stable_server_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewResponse) ProtoMessage() {}
+
+func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_fast_stable_server_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_package_proto_fast_stable_server_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateReviewResponse) GetReviewId() int32 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+type GetHotelDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       int32                  `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomTypeId    int32                  `protobuf:"varint,2,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotelDetailsRequest) Reset() {
+	*x = GetHotelDetailsRequest{}
+	mi := &file_package_proto_fast_stable_server_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotelDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotelDetailsRequest) ProtoMessage() {}
+
+func (x *GetHotelDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_fast_stable_server_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotelDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetHotelDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_package_proto_fast_stable_server_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetHotelDetailsRequest) GetHotelId() int32 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *GetHotelDetailsRequest) GetRoomTypeId() int32 {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return 0
+}
+
+type GetHotelDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelName     string                 `protobuf:"bytes,1,opt,name=hotel_name,json=hotelName,proto3" json:"hotel_name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ContactPhone  string                 `protobuf:"bytes,3,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	RoomTypeName  string                 `protobuf:"bytes,4,opt,name=room_type_name,json=roomTypeName,proto3" json:"room_type_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotelDetailsResponse) Reset() {
+	*x = GetHotelDetailsResponse{}
+	mi := &file_package_proto_fast_stable_server_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotelDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotelDetailsResponse) ProtoMessage() {}
+
+func (x *GetHotelDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_package_proto_fast_stable_server_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotelDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetHotelDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_package_proto_fast_stable_server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetHotelDetailsResponse) GetHotelName() string {
+	if x != nil {
+		return x.HotelName
+	}
+	return ""
+}
+
+func (x *GetHotelDetailsResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetHotelDetailsResponse) GetContactPhone() string {
+	if x != nil {
+		return x.ContactPhone
+	}
+	return ""
+}
+
+func (x *GetHotelDetailsResponse) GetRoomTypeName() string {
+	if x != nil {
+		return x.RoomTypeName
+	}
+	return ""
+}
+
 var File_package_proto_fast_stable_server_proto protoreflect.FileDescriptor
 
 const file_package_proto_fast_stable_server_proto_rawDesc = "" +
@@ -238,11 +478,32 @@ const file_package_proto_fast_stable_server_proto_rawDesc = "" +
 	"\froom_type_id\x18\x02 \x01(\x05R\n" +
 	"roomTypeId\"/\n" +
 	"\x12GetRoomsIDResponse\x12\x19\n" +
-	"\broom_ids\x18\x01 \x03(\x05R\aroomIds2\xa6\x01\n" +
+	"\broom_ids\x18\x01 \x03(\x05R\aroomIds\"\x94\x01\n" +
+	"\x13CreateReviewRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x05R\ahotelId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\x05R\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\"3\n" +
+	"\x14CreateReviewResponse\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x05R\breviewId\"U\n" +
+	"\x16GetHotelDetailsRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x05R\ahotelId\x12 \n" +
+	"\froom_type_id\x18\x02 \x01(\x05R\n" +
+	"roomTypeId\"\x9d\x01\n" +
+	"\x17GetHotelDetailsResponse\x12\x1d\n" +
+	"\n" +
+	"hotel_name\x18\x01 \x01(\tR\thotelName\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12#\n" +
+	"\rcontact_phone\x18\x03 \x01(\tR\fcontactPhone\x12$\n" +
+	"\x0eroom_type_name\x18\x04 \x01(\tR\froomTypeName2\xcd\x02\n" +
 	"\fHotelService\x12M\n" +
 	"\fGetRoomPrice\x12\x1d.hotel.v1.GetRoomPriceRequest\x1a\x1e.hotel.v1.GetRoomPriceResponse\x12G\n" +
 	"\n" +
-	"GetRoomsID\x12\x1b.hotel.v1.GetRoomsIDRequest\x1a\x1c.hotel.v1.GetRoomsIDResponseB8Z6booking-service/project/package/fast/stable;faststableb\x06proto3"
+	"GetRoomsID\x12\x1b.hotel.v1.GetRoomsIDRequest\x1a\x1c.hotel.v1.GetRoomsIDResponse\x12M\n" +
+	"\fCreateReview\x12\x1d.hotel.v1.CreateReviewRequest\x1a\x1e.hotel.v1.CreateReviewResponse\x12V\n" +
+	"\x0fGetHotelDetails\x12 .hotel.v1.GetHotelDetailsRequest\x1a!.hotel.v1.GetHotelDetailsResponseB8Z6booking-service/project/package/fast/stable;faststableb\x06proto3"
 
 var (
 	file_package_proto_fast_stable_server_proto_rawDescOnce sync.Once
@@ -256,20 +517,28 @@ func file_package_proto_fast_stable_server_proto_rawDescGZIP() []byte {
 	return file_package_proto_fast_stable_server_proto_rawDescData
 }
 
-var file_package_proto_fast_stable_server_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_package_proto_fast_stable_server_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_package_proto_fast_stable_server_proto_goTypes = []any{
-	(*GetRoomPriceRequest)(nil),  // 0: hotel.v1.GetRoomPriceRequest
-	(*GetRoomPriceResponse)(nil), // 1: hotel.v1.GetRoomPriceResponse
-	(*GetRoomsIDRequest)(nil),    // 2: hotel.v1.GetRoomsIDRequest
-	(*GetRoomsIDResponse)(nil),   // 3: hotel.v1.GetRoomsIDResponse
+	(*GetRoomPriceRequest)(nil),     // 0: hotel.v1.GetRoomPriceRequest
+	(*GetRoomPriceResponse)(nil),    // 1: hotel.v1.GetRoomPriceResponse
+	(*GetRoomsIDRequest)(nil),       // 2: hotel.v1.GetRoomsIDRequest
+	(*GetRoomsIDResponse)(nil),      // 3: hotel.v1.GetRoomsIDResponse
+	(*CreateReviewRequest)(nil),     // 4: hotel.v1.CreateReviewRequest
+	(*CreateReviewResponse)(nil),    // 5: hotel.v1.CreateReviewResponse
+	(*GetHotelDetailsRequest)(nil),  // 6: hotel.v1.GetHotelDetailsRequest
+	(*GetHotelDetailsResponse)(nil), // 7: hotel.v1.GetHotelDetailsResponse
 }
 var file_package_proto_fast_stable_server_proto_depIdxs = []int32{
 	0, // 0: hotel.v1.HotelService.GetRoomPrice:input_type -> hotel.v1.GetRoomPriceRequest
 	2, // 1: hotel.v1.HotelService.GetRoomsID:input_type -> hotel.v1.GetRoomsIDRequest
-	1, // 2: hotel.v1.HotelService.GetRoomPrice:output_type -> hotel.v1.GetRoomPriceResponse
-	3, // 3: hotel.v1.HotelService.GetRoomsID:output_type -> hotel.v1.GetRoomsIDResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	4, // 2: hotel.v1.HotelService.CreateReview:input_type -> hotel.v1.CreateReviewRequest
+	6, // 3: hotel.v1.HotelService.GetHotelDetails:input_type -> hotel.v1.GetHotelDetailsRequest
+	1, // 4: hotel.v1.HotelService.GetRoomPrice:output_type -> hotel.v1.GetRoomPriceResponse
+	3, // 5: hotel.v1.HotelService.GetRoomsID:output_type -> hotel.v1.GetRoomsIDResponse
+	5, // 6: hotel.v1.HotelService.CreateReview:output_type -> hotel.v1.CreateReviewResponse
+	7, // 7: hotel.v1.HotelService.GetHotelDetails:output_type -> hotel.v1.GetHotelDetailsResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -286,7 +555,7 @@ func file_package_proto_fast_stable_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_package_proto_fast_stable_server_proto_rawDesc), len(file_package_proto_fast_stable_server_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HotelService_GetRoomPrice_FullMethodName = "/hotel.v1.HotelService/GetRoomPrice"
-	HotelService_GetRoomsID_FullMethodName   = "/hotel.v1.HotelService/GetRoomsID"
+	HotelService_GetRoomPrice_FullMethodName    = "/hotel.v1.HotelService/GetRoomPrice"
+	HotelService_GetRoomsID_FullMethodName      = "/hotel.v1.HotelService/GetRoomsID"
+	HotelService_CreateReview_FullMethodName    = "/hotel.v1.HotelService/CreateReview"
+	HotelService_GetHotelDetails_FullMethodName = "/hotel.v1.HotelService/GetHotelDetails"
 )
 
 // HotelServiceClient is the client API for HotelService service.
@@ -29,6 +31,8 @@ const (
 type HotelServiceClient interface {
 	GetRoomPrice(ctx context.Context, in *GetRoomPriceRequest, opts ...grpc.CallOption) (*GetRoomPriceResponse, error)
 	GetRoomsID(ctx context.Context, in *GetRoomsIDRequest, opts ...grpc.CallOption) (*GetRoomsIDResponse, error)
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error)
+	GetHotelDetails(ctx context.Context, in *GetHotelDetailsRequest, opts ...grpc.CallOption) (*GetHotelDetailsResponse, error)
 }
 
 type hotelServiceClient struct {
@@ -59,12 +63,34 @@ func (c *hotelServiceClient) GetRoomsID(ctx context.Context, in *GetRoomsIDReque
 	return out, nil
 }
 
+func (c *hotelServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReviewResponse)
+	err := c.cc.Invoke(ctx, HotelService_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) GetHotelDetails(ctx context.Context, in *GetHotelDetailsRequest, opts ...grpc.CallOption) (*GetHotelDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHotelDetailsResponse)
+	err := c.cc.Invoke(ctx, HotelService_GetHotelDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HotelServiceServer is the server API for HotelService service.
 // All implementations must embed UnimplementedHotelServiceServer
 // for forward compatibility.
 type HotelServiceServer interface {
 	GetRoomPrice(context.Context, *GetRoomPriceRequest) (*GetRoomPriceResponse, error)
 	GetRoomsID(context.Context, *GetRoomsIDRequest) (*GetRoomsIDResponse, error)
+	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error)
+	GetHotelDetails(context.Context, *GetHotelDetailsRequest) (*GetHotelDetailsResponse, error)
 	mustEmbedUnimplementedHotelServiceServer()
 }
 
@@ -81,6 +107,12 @@ func (UnimplementedHotelServiceServer) GetRoomPrice(context.Context, *GetRoomPri
 func (UnimplementedHotelServiceServer) GetRoomsID(context.Context, *GetRoomsIDRequest) (*GetRoomsIDResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetRoomsID not implemented")
 }
+func (UnimplementedHotelServiceServer) CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedHotelServiceServer) GetHotelDetails(context.Context, *GetHotelDetailsRequest) (*GetHotelDetailsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHotelDetails not implemented")
+}
 func (UnimplementedHotelServiceServer) mustEmbedUnimplementedHotelServiceServer() {}
 func (UnimplementedHotelServiceServer) testEmbeddedByValue()                      {}
 
@@ -138,6 +170,42 @@ func _HotelService_GetRoomsID_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HotelService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_GetHotelDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotelDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).GetHotelDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_GetHotelDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).GetHotelDetails(ctx, req.(*GetHotelDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HotelService_ServiceDesc is the grpc.ServiceDesc for HotelService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +221,14 @@ var HotelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRoomsID",
 			Handler:    _HotelService_GetRoomsID_Handler,
 		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _HotelService_CreateReview_Handler,
+		},
+		{
+			MethodName: "GetHotelDetails",
+			Handler:    _HotelService_GetHotelDetails_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "package/proto/fast/stable/server.proto",
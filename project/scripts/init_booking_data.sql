@@ -1,7 +1,7 @@
 -- Тестовые данные для базы booking_db
 
 -- Очистка существующих данных
-TRUNCATE TABLE bookings, users RESTART IDENTITY;
+TRUNCATE TABLE booking_events, loyalty_ledger, bookings, users, cancellation_policies, calendar_tokens, room_blocks, outbox, sagas RESTART IDENTITY;
 
 INSERT INTO users (email, full_name, phone) VALUES
 ('ivan.ivanov@mail.ru', 'Иван Иванов', '+79161234567'),
@@ -27,4 +27,11 @@ INSERT INTO bookings (user_id, hotel_id, room_id, check_in_date, check_out_date,
 (9, 3, 303, '2024-05-15', '2024-05-20', 2, 550.00),
 (10, 1, 104, '2024-06-01', '2024-06-07', 2, 700.00),
 (1, 2, 204, '2024-06-15', '2024-06-20', 2, 650.00),
-(2, 3, 304, '2024-07-01', '2024-07-10', 3, 1350.00);
\ No newline at end of file
+(2, 3, 304, '2024-07-01', '2024-07-10', 3, 1350.00);
+
+INSERT INTO cancellation_policies (hotel_id, no_show_cutoff, no_show_penalty_nights) VALUES
+(1, '23:59', 1),
+(2, '18:00', 1),
+(3, '23:59', 2),
+(4, '20:00', 1),
+(5, '23:59', 1);
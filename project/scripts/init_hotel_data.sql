@@ -1,7 +1,7 @@
 -- Тестовые данные для базы hotel_db
 
 -- Очистка существующих данных
-TRUNCATE TABLE rooms, room_types_in_hotels, hotels RESTART IDENTITY;
+TRUNCATE TABLE reviews, rooms, room_types_in_hotels, hotels RESTART IDENTITY;
 
 INSERT INTO hotels (name, address, contact_phone) VALUES
 ('Гранд Отель Москва', 'ул. Тверская, д. 1, Москва', '+74951234567'),